@@ -0,0 +1,184 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/startup"
+	"github.com/lusoris/venio/migrations"
+)
+
+// envFlags are flags that override a configuration variable, taking
+// precedence over the environment, .env, and the configuration file.
+var envFlags = []struct {
+	name  string
+	key   string
+	usage string
+}{
+	{"port", "PORT", "Port to listen on"},
+	{"env", "ENV", "Environment: development, production, or test"},
+	{"database-url", "DATABASE_URL", "PostgreSQL connection URL"},
+	{"log-level", "LOG_LEVEL", "Log level: debug, info, warn, or error"},
+}
+
+// defineEnvFlags registers envFlags on the command line.
+func defineEnvFlags() {
+	for _, f := range envFlags {
+		flag.String(f.name, "", fmt.Sprintf("%s (overrides %s)", f.usage, f.key))
+	}
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [config validate | config print | migrate up | migrate down | migrate status]\n\nFlags:\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+// envFlagOverrides returns the variables of the envFlags given on the
+// command line, for config.Load and reloads to apply over all sources.
+func envFlagOverrides() map[string]string {
+	overrides := map[string]string{}
+	flag.Visit(func(set *flag.Flag) {
+		for _, f := range envFlags {
+			if f.name == set.Name {
+				overrides[f.key] = set.Value.String()
+			}
+		}
+	})
+	return overrides
+}
+
+// runCommand runs the subcommand in args instead of the server and returns
+// the exit code.
+func runCommand(args []string, configPath string, overrides map[string]string) int {
+	switch strings.Join(args, " ") {
+	case "config validate":
+		return validateConfig(configPath, overrides)
+	case "config print":
+		return printConfig(configPath, overrides)
+	case "migrate up", "migrate down", "migrate status":
+		return migrate(configPath, overrides, args[1])
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q; available: config validate, config print, migrate up, migrate down, migrate status\n", strings.Join(args, " "))
+		return 2
+	}
+}
+
+// validateConfig loads the configuration and reports every problem with it.
+func validateConfig(configPath string, overrides map[string]string) int {
+	if _, err := loadConfig(configPath, overrides); err != nil {
+		return 1
+	}
+
+	fmt.Println("Configuration is valid")
+	return 0
+}
+
+// printConfig prints the effective configuration as sorted KEY=value
+// lines, with secrets redacted.
+func printConfig(configPath string, overrides map[string]string) int {
+	cfg, err := loadConfig(configPath, overrides)
+	if err != nil {
+		return 1
+	}
+
+	values := cfg.Redacted()
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		fmt.Printf("%s=%s\n", key, values[key])
+	}
+	return 0
+}
+
+// loadConfig loads the configuration, printing every problem with it to
+// stderr.
+func loadConfig(configPath string, overrides map[string]string) (*config.Config, error) {
+	cfg, err := config.Load(configPath, overrides)
+	if err != nil {
+		var problems config.Problems
+		if !errors.As(err, &problems) {
+			fmt.Fprintln(os.Stderr, err)
+			return nil, err
+		}
+		fmt.Fprintln(os.Stderr, "Configuration is invalid:")
+		for _, problem := range problems {
+			fmt.Fprintf(os.Stderr, "  - %v\n", problem)
+		}
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// migrate applies all pending migrations, reverts the last one, or lists
+// them, depending on action.
+func migrate(configPath string, overrides map[string]string, action string) int {
+	cfg, err := loadConfig(configPath, overrides)
+	if err != nil {
+		return 1
+	}
+
+	ctx := context.Background()
+	var db *pgxpool.Pool
+	err = startup.Wait(ctx, "database", cfg.Startup, func(ctx context.Context) error {
+		db, err = database.Connect(ctx, cfg.Database)
+		return err
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to connect to database: %v\n", err)
+		return 1
+	}
+	defer db.Close()
+
+	migrator, err := database.NewMigrator(db, migrations.FS)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	switch action {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("Applied %d migrations\n", applied)
+	case "down":
+		reverted, err := migrator.Down(ctx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		if reverted == nil {
+			fmt.Println("No migration to revert")
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.Applied {
+				state = "applied"
+			}
+			fmt.Printf("%-8s %d_%s\n", state, status.Version, status.Name)
+		}
+	}
+	return 0
+}
@@ -8,30 +8,260 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"log"
 	"os"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/lusoris/venio/internal/api"
+	"github.com/lusoris/venio/internal/cache"
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/encryption"
+	"github.com/lusoris/venio/internal/i18n"
+	"github.com/lusoris/venio/internal/jobs"
+	"github.com/lusoris/venio/internal/notifications"
+	"github.com/lusoris/venio/internal/outbox"
+	"github.com/lusoris/venio/internal/ratelimit"
+	"github.com/lusoris/venio/internal/repositories"
+	"github.com/lusoris/venio/internal/server"
+	"github.com/lusoris/venio/internal/services"
+	"github.com/lusoris/venio/internal/startup"
+	"github.com/lusoris/venio/internal/stream"
+	"github.com/lusoris/venio/internal/tasks"
+	"github.com/lusoris/venio/internal/webhooks"
+	"github.com/lusoris/venio/internal/worker"
+	"github.com/lusoris/venio/migrations"
 )
 
 const version = "0.1.0-dev"
 
 func main() {
-	// Parse CLI flags
-	if len(os.Args) > 1 && (os.Args[1] == "--version" || os.Args[1] == "-v") {
+	configPath := flag.String("config", "", "YAML or TOML configuration file")
+	showVersion := flag.Bool("version", false, "Print the version and exit")
+	flag.BoolVar(showVersion, "v", false, "Print the version and exit")
+	defineEnvFlags()
+	flag.Parse()
+	overrides := envFlagOverrides()
+	if *showVersion {
 		fmt.Printf("Venio v%s\n", version)
 		os.Exit(0)
 	}
+	if args := flag.Args(); len(args) > 0 {
+		os.Exit(runCommand(args, *configPath, overrides))
+	}
 
 	log.Println("🚀 Starting Venio Server...")
 	log.Printf("Version: %s", version)
 
-	// TODO: Initialize configuration
-	// TODO: Initialize database
-	// TODO: Initialize API server
-	// TODO: Start server
+	cfg, err := config.Load(*configPath, overrides)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	errorReporter, closeErrorReporter, err := worker.NewErrorReporter(cfg.ErrorTracking, version)
+	if err != nil {
+		log.Fatalf("Failed to initialize error tracking: %v", err)
+	}
+	defer closeErrorReporter()
+
+	var db *pgxpool.Pool
+	err = startup.Wait(context.Background(), "database", cfg.Startup, func(ctx context.Context) error {
+		db, err = database.Connect(ctx, cfg.Database)
+		return err
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	if cfg.Database.AutoMigrate {
+		migrator, err := database.NewMigrator(db, migrations.FS)
+		if err != nil {
+			log.Fatalf("Failed to load migrations: %v", err)
+		}
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to migrate database: %v", err)
+		}
+		log.Printf("Database schema is up to date (%d migrations applied)", applied)
+	}
+
+	// Admin listings read from the replica if one is configured.
+	replica, err := database.ConnectReplica(context.Background(), cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to connect to read replica: %v", err)
+	}
+	if replica != nil {
+		defer replica.Close()
+	}
+	reads := database.NewReplica(db, replica)
+
+	encryptor, err := encryption.NewEncryptor(cfg.Security.EncryptionKey)
+	if err != nil {
+		log.Fatalf("Failed to initialize encryption: %v", err)
+	}
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr(),
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	defer redisClient.Close()
+
+	if err := startup.Wait(context.Background(), "Redis", cfg.Startup, func(ctx context.Context) error {
+		return redisClient.Ping(ctx).Err()
+	}); err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+
+	redisCache := cache.New(redisClient)
+	responseCache := cache.NewResponseCache(redisCache)
+	metadataCache := cache.NewMetadataCache(redisCache, cache.TTLs{
+		TMDB:        cfg.Cache.TMDBTTL,
+		ArrProfiles: cfg.Cache.ArrProfilesTTL,
+		PlexGUID:    cfg.Cache.PlexGUIDTTL,
+	})
 
-	log.Println("✅ Venio Server running on http://localhost:3690")
+	jobStore := jobs.NewStore(redisClient, cfg.Worker.JobStatusTTL)
+	broker := stream.NewBroker(redisClient, repositories.NewOutboxRepository(db))
+	jobRuns := repositories.NewJobRunRepository(db).WithReplica(reads)
+	auditEntries := repositories.NewAuditRepository(db)
+	auditLogger, closeAudit := worker.NewAuditLogger(cfg.Audit, auditEntries)
+	defer closeAudit()
 
-	// Keep running
-	select {}
+	var enqueuer tasks.Enqueuer
+	var inlineEnqueuer *tasks.InlineEnqueuer
+	if cfg.Worker.Mode == config.WorkerModeInline {
+		inlineEnqueuer = tasks.NewInlineEnqueuer()
+		inlineEnqueuer.SetErrorHandler(worker.TaskErrorReporter(errorReporter))
+		enqueuer = inlineEnqueuer
+		log.Println("Background tasks run inline (WORKER_MODE=inline)")
+	} else {
+		taskClient := asynq.NewClient(worker.RedisOpt(cfg.Redis))
+		defer taskClient.Close()
+		enqueuer = tasks.NewAsynqEnqueuer(taskClient, worker.NewRetryPolicies(cfg.Worker))
+	}
+
+	inspector := asynq.NewInspector(worker.RedisOpt(cfg.Redis))
+	defer inspector.Close()
+
+	instanceService := services.NewInstanceService(
+		db,
+		repositories.NewInstanceRepository(db).WithReplica(reads),
+		repositories.NewOutboxRepository(db),
+		encryptor,
+		enqueuer,
+		jobStore,
+		responseCache,
+		auditLogger,
+	)
+
+	bundle, err := i18n.NewBundle(cfg.I18n.DefaultLocale)
+	if err != nil {
+		log.Fatalf("Failed to load translations: %v", err)
+	}
+
+	webhookService := services.NewWebhookService(
+		db,
+		repositories.NewWebhookRepository(db).WithReplica(reads),
+		encryptor,
+		enqueuer,
+		webhooks.NewSender(cfg.Webhook.Timeout),
+		auditLogger,
+		cfg.Webhook.MaxFailures,
+	)
+	notificationService := services.NewNotificationService(
+		repositories.NewNotificationAgentRepository(db).WithReplica(reads),
+		encryptor,
+		enqueuer,
+		bundle,
+		notifications.NewThrottle(redisClient, cfg.Notification.Throttle),
+		auditLogger,
+		cfg.Webhook.Timeout,
+	)
+
+	// The API process needs email even with a separate worker, to send
+	// test emails right away.
+	emailSuppressions := repositories.NewEmailSuppressionRepository(db).WithReplica(reads)
+	emailRenderer, emailSender, err := worker.NewEmail(cfg.Email, bundle, emailSuppressions)
+	if err != nil {
+		log.Fatalf("Failed to initialize email: %v", err)
+	}
+
+	if inlineEnqueuer != nil {
+		inlineEnqueuer.SetHandler(worker.NewMux(worker.Dependencies{
+			MetadataCache:       metadataCache,
+			InstanceService:     instanceService,
+			WebhookService:      webhookService,
+			Notifications:       notificationService,
+			JobStore:            jobStore,
+			JobRuns:             jobRuns,
+			AuditEntries:        auditEntries,
+			EmailRenderer:       emailRenderer,
+			EmailSender:         emailSender,
+			WebhookRetention:    cfg.Webhook.DeliveryRetention,
+			JobHistoryRetention: cfg.Worker.JobHistoryRetention,
+			AuditRetention:      cfg.Audit.Retention,
+			ArrRequestTimeout:   cfg.Worker.ArrRequestTimeout,
+		}))
+
+		// Without a separate worker the API process relays outbox events.
+		relayCtx, stopRelay := context.WithCancel(context.Background())
+		relayDone := make(chan struct{})
+		go func() {
+			defer close(relayDone)
+			outbox.NewRelay(db, worker.NewDispatcher(webhookService, notificationService, broker), worker.OutboxConfig(cfg.Outbox)).Run(relayCtx)
+		}()
+		// The relay records audit entries, so it stops before the audit
+		// logger is closed.
+		defer func() {
+			stopRelay()
+			<-relayDone
+		}()
+	}
+
+	// Feed events published by any relay to the streams of this instance.
+	streamCtx, stopStream := context.WithCancel(context.Background())
+	defer stopStream()
+	go broker.Run(streamCtx)
+
+	reloader := config.NewReloader(*configPath, overrides)
+	router, err := api.NewRouter(api.Dependencies{
+		Config:              cfg,
+		Reloader:            reloader,
+		Bundle:              bundle,
+		AuditLogger:         auditLogger,
+		ErrorReporter:       errorReporter,
+		MetadataCache:       metadataCache,
+		ResponseCache:       responseCache,
+		InstanceService:     instanceService,
+		JobService:          services.NewJobService(inspector, jobStore, jobRuns),
+		WebhookService:      webhookService,
+		NotificationService: notificationService,
+		EmailService:        services.NewEmailService(emailRenderer, emailSender, bundle, emailSuppressions, auditLogger),
+		EventBroker:         broker,
+		RateLimiter:         ratelimit.New(redisClient),
+	})
+	if err != nil {
+		log.Fatalf("Failed to build router: %v", err)
+	}
+
+	// With graceful restarts SIGHUP restarts the server, which reloads
+	// everything.
+	if !cfg.Server.GracefulRestart {
+		reloader.ReloadOnSIGHUP()
+	}
+
+	log.Println("✅ Venio Server running")
+
+	// Run blocks until SIGINT/SIGTERM and then drains in-flight requests.
+	if err := server.Run(cfg.Server, cfg.TLS, router); err != nil {
+		log.Fatalf("Server stopped: %v", err)
+	}
 }
@@ -8,16 +8,42 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"log"
 	"os"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/lusoris/venio/internal/cache"
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/encryption"
+	"github.com/lusoris/venio/internal/i18n"
+	"github.com/lusoris/venio/internal/jobs"
+	"github.com/lusoris/venio/internal/notifications"
+	"github.com/lusoris/venio/internal/outbox"
+	"github.com/lusoris/venio/internal/repositories"
+	"github.com/lusoris/venio/internal/services"
+	"github.com/lusoris/venio/internal/startup"
+	"github.com/lusoris/venio/internal/stream"
+	"github.com/lusoris/venio/internal/tasks"
+	"github.com/lusoris/venio/internal/webhooks"
+	"github.com/lusoris/venio/internal/worker"
+	"github.com/lusoris/venio/migrations"
 )
 
 const version = "0.1.0-dev"
 
 func main() {
-	// Parse CLI flags
-	if len(os.Args) > 1 && (os.Args[1] == "--version" || os.Args[1] == "-v") {
+	configPath := flag.String("config", "", "YAML or TOML configuration file")
+	showVersion := flag.Bool("version", false, "Print the version and exit")
+	flag.BoolVar(showVersion, "v", false, "Print the version and exit")
+	flag.Parse()
+	if *showVersion {
 		fmt.Printf("Venio Worker v%s\n", version)
 		os.Exit(0)
 	}
@@ -25,14 +51,162 @@ func main() {
 	log.Println("🔧 Starting Venio Worker...")
 	log.Printf("Version: %s", version)
 
-	// TODO: Initialize configuration
-	// TODO: Initialize database connection
-	// TODO: Initialize job queue (asynq)
-	// TODO: Register job handlers
-	// TODO: Start worker
+	cfg, err := config.Load(*configPath, nil)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	errorReporter, closeErrorReporter, err := worker.NewErrorReporter(cfg.ErrorTracking, version)
+	if err != nil {
+		log.Fatalf("Failed to initialize error tracking: %v", err)
+	}
+	defer closeErrorReporter()
+
+	var db *pgxpool.Pool
+	err = startup.Wait(context.Background(), "database", cfg.Startup, func(ctx context.Context) error {
+		db, err = database.Connect(ctx, cfg.Database)
+		return err
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	if cfg.Database.AutoMigrate {
+		migrator, err := database.NewMigrator(db, migrations.FS)
+		if err != nil {
+			log.Fatalf("Failed to load migrations: %v", err)
+		}
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to migrate database: %v", err)
+		}
+		log.Printf("Database schema is up to date (%d migrations applied)", applied)
+	}
+
+	encryptor, err := encryption.NewEncryptor(cfg.Security.EncryptionKey)
+	if err != nil {
+		log.Fatalf("Failed to initialize encryption: %v", err)
+	}
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr(),
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	defer redisClient.Close()
+
+	if err := startup.Wait(context.Background(), "Redis", cfg.Startup, func(ctx context.Context) error {
+		return redisClient.Ping(ctx).Err()
+	}); err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+
+	redisCache := cache.New(redisClient)
+	responseCache := cache.NewResponseCache(redisCache)
+	metadataCache := cache.NewMetadataCache(redisCache, cache.TTLs{
+		TMDB:        cfg.Cache.TMDBTTL,
+		ArrProfiles: cfg.Cache.ArrProfilesTTL,
+		PlexGUID:    cfg.Cache.PlexGUIDTTL,
+	})
+
+	jobStore := jobs.NewStore(redisClient, cfg.Worker.JobStatusTTL)
+	broker := stream.NewBroker(redisClient, repositories.NewOutboxRepository(db))
+	jobRuns := repositories.NewJobRunRepository(db)
+	auditEntries := repositories.NewAuditRepository(db)
+	auditLogger, closeAudit := worker.NewAuditLogger(cfg.Audit, auditEntries)
+	defer closeAudit()
+
+	// Services may enqueue follow-up tasks.
+	taskClient := asynq.NewClient(worker.RedisOpt(cfg.Redis))
+	defer taskClient.Close()
+	policies := worker.NewRetryPolicies(cfg.Worker)
+	enqueuer := tasks.NewAsynqEnqueuer(taskClient, policies)
+
+	instanceService := services.NewInstanceService(
+		db,
+		repositories.NewInstanceRepository(db),
+		repositories.NewOutboxRepository(db),
+		encryptor,
+		enqueuer,
+		jobStore,
+		responseCache,
+		auditLogger,
+	)
+
+	bundle, err := i18n.NewBundle(cfg.I18n.DefaultLocale)
+	if err != nil {
+		log.Fatalf("Failed to load translations: %v", err)
+	}
+
+	webhookService := services.NewWebhookService(
+		db,
+		repositories.NewWebhookRepository(db),
+		encryptor,
+		enqueuer,
+		webhooks.NewSender(cfg.Webhook.Timeout),
+		auditLogger,
+		cfg.Webhook.MaxFailures,
+	)
+	notificationService := services.NewNotificationService(
+		repositories.NewNotificationAgentRepository(db),
+		encryptor,
+		enqueuer,
+		bundle,
+		notifications.NewThrottle(redisClient, cfg.Notification.Throttle),
+		auditLogger,
+		cfg.Webhook.Timeout,
+	)
+
+	emailRenderer, emailSender, err := worker.NewEmail(cfg.Email, bundle, repositories.NewEmailSuppressionRepository(db))
+	if err != nil {
+		log.Fatalf("Failed to initialize email: %v", err)
+	}
+
+	mux := worker.NewMux(worker.Dependencies{
+		MetadataCache:       metadataCache,
+		InstanceService:     instanceService,
+		WebhookService:      webhookService,
+		Notifications:       notificationService,
+		JobStore:            jobStore,
+		JobRuns:             jobRuns,
+		AuditEntries:        auditEntries,
+		EmailRenderer:       emailRenderer,
+		EmailSender:         emailSender,
+		WebhookRetention:    cfg.Webhook.DeliveryRetention,
+		JobHistoryRetention: cfg.Worker.JobHistoryRetention,
+		AuditRetention:      cfg.Audit.Retention,
+		ArrRequestTimeout:   cfg.Worker.ArrRequestTimeout,
+	})
+
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		outbox.NewRelay(db, worker.NewDispatcher(webhookService, notificationService, broker), worker.OutboxConfig(cfg.Outbox)).Run(relayCtx)
+	}()
+	// The relay records audit entries, so it stops before the audit
+	// logger is closed.
+	defer func() {
+		stopRelay()
+		<-relayDone
+	}()
+
+	scheduler, err := worker.NewScheduler(cfg)
+	if err != nil {
+		log.Fatalf("Failed to create scheduler: %v", err)
+	}
+	if err := scheduler.Start(); err != nil {
+		log.Fatalf("Failed to start scheduler: %v", err)
+	}
+	defer scheduler.Shutdown()
+
+	servers := worker.NewServers(cfg, policies, errorReporter)
 
 	log.Println("✅ Venio Worker running")
 
-	// Keep running
-	select {}
+	// Run blocks until SIGINT/SIGTERM and then drains in-flight tasks.
+	if err := worker.Run(servers, mux); err != nil {
+		log.Fatalf("Worker stopped: %v", err)
+	}
 }
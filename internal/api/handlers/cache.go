@@ -0,0 +1,48 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package handlers implements the HTTP handlers of the API.
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/response"
+	"github.com/lusoris/venio/internal/cache"
+)
+
+// CacheHandler exposes cache administration endpoints.
+type CacheHandler struct {
+	metadata *cache.MetadataCache
+}
+
+// NewCacheHandler creates a new cache handler.
+func NewCacheHandler(metadata *cache.MetadataCache) *CacheHandler {
+	return &CacheHandler{metadata: metadata}
+}
+
+// InvalidateMetadata handles DELETE /api/v1/admin/cache/metadata.
+// The optional "kind" query parameter limits invalidation to one kind of
+// metadata (tmdb, arr_profiles, plex_guid); without it everything is purged.
+func (h *CacheHandler) InvalidateMetadata(c *gin.Context) {
+	kind := cache.Kind(c.Query("kind"))
+	if kind != "" && !kind.Valid() {
+		response.Error(c, http.StatusBadRequest, response.CodeValidationError, "error.unknown_cache_kind")
+		return
+	}
+
+	deleted, err := h.metadata.Invalidate(c.Request.Context(), kind)
+	if err != nil {
+		_ = c.Error(err)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.invalidate_cache")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deleted": deleted})
+}
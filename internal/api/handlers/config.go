@@ -0,0 +1,33 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/config"
+)
+
+// ConfigHandler exposes the configuration of the running process.
+type ConfigHandler struct {
+	config *config.Value[*config.Config]
+}
+
+// NewConfigHandler creates a new config handler for the configuration in
+// cfg, which follows reloads.
+func NewConfigHandler(cfg *config.Value[*config.Config]) *ConfigHandler {
+	return &ConfigHandler{config: cfg}
+}
+
+// Get handles GET /api/v1/admin/config. It returns the effective value of
+// every variable, defaults included, with secrets redacted.
+func (h *ConfigHandler) Get(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"config": h.config.Load().Redacted()})
+}
@@ -0,0 +1,167 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/middleware"
+	"github.com/lusoris/venio/internal/api/response"
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/email"
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/services"
+)
+
+// EmailHandler exposes endpoints for checking the email setup and for
+// bounce and complaint reports from the provider.
+type EmailHandler struct {
+	service       *services.EmailService
+	feedbackToken string
+	pageSize      config.PageSize
+}
+
+// NewEmailHandler creates a new email handler. Provider feedback requests
+// must carry feedbackToken in the token query parameter. The suppression
+// listing returns pageSize addresses per page.
+func NewEmailHandler(service *services.EmailService, feedbackToken string, pageSize config.PageSize) *EmailHandler {
+	return &EmailHandler{service: service, feedbackToken: feedbackToken, pageSize: pageSize}
+}
+
+// Preview handles GET /api/v1/admin/email/templates/:name/preview.
+// Query parameters: locale (default: the request's locale), and format
+// (json, html, or text). The html and text formats return the body alone so
+// it can be opened in a browser.
+func (h *EmailHandler) Preview(c *gin.Context) {
+	msg, err := h.service.Preview(c.Param("name"), c.DefaultQuery("locale", c.GetString(middleware.LocaleKey)))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	switch c.DefaultQuery("format", "json") {
+	case "json":
+		c.JSON(http.StatusOK, gin.H{"subject": msg.Subject, "text": msg.Text, "html": msg.HTML})
+	case "html":
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(msg.HTML))
+	case "text":
+		c.String(http.StatusOK, msg.Text)
+	default:
+		response.Error(c, http.StatusBadRequest, response.CodeValidationError, "error.invalid_preview_format")
+	}
+}
+
+// Test handles POST /api/v1/admin/email/test. The email is sent right away
+// and a failed send is reported in the body rather than as an HTTP error.
+// Without a locale in the request body the request's locale is used.
+func (h *EmailHandler) Test(c *gin.Context) {
+	var req models.TestEmailRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+	if req.Locale == "" {
+		req.Locale = c.GetString(middleware.LocaleKey)
+	}
+
+	err := h.service.SendTest(c.Request.Context(), req.To, req.Locale)
+	if errors.Is(err, services.ErrInvalidEmailAddress) {
+		h.handleError(c, err)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{"success": false, "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// Feedback handles POST /api/v1/email/feedback/:provider?token=... for
+// provider bounce and complaint webhooks. Providers cannot send the API
+// key, so the request is authenticated by a token in the URL.
+func (h *EmailHandler) Feedback(c *gin.Context) {
+	if h.feedbackToken == "" {
+		response.Error(c, http.StatusForbidden, response.CodeForbidden, "error.email_feedback_not_configured")
+		return
+	}
+	if !middleware.ValidAPIKey(h.feedbackToken, c.Query("token")) {
+		response.Error(c, http.StatusUnauthorized, response.CodeUnauthorized, "error.invalid_token")
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		if !bodyTooLarge(c, err) {
+			response.Error(c, http.StatusBadRequest, response.CodeValidationError, "error.read_body")
+		}
+		return
+	}
+
+	suppressed, err := h.service.HandleFeedback(c.Request.Context(), c.Param("provider"), body)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"suppressed": suppressed})
+}
+
+// ListSuppressions handles GET /api/v1/admin/email/suppressions.
+// Query parameters: search, page, per_page.
+func (h *EmailHandler) ListSuppressions(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page < 1 {
+		page = 1
+	}
+	perPage := h.pageSize.Of(c.Query("per_page"))
+
+	suppressions, err := h.service.ListSuppressions(c.Request.Context(), c.Query("search"), page, perPage)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"suppressions": suppressions, "page": page, "per_page": perPage})
+}
+
+// DeleteSuppression handles DELETE /api/v1/admin/email/suppressions/:id.
+func (h *EmailHandler) DeleteSuppression(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteSuppression(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// handleError maps service errors to HTTP responses.
+func (h *EmailHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrEmailTemplateNotFound):
+		response.Error(c, http.StatusNotFound, response.CodeNotFound, "error.email_template_not_found")
+	case errors.Is(err, services.ErrEmailSuppressionNotFound):
+		response.Error(c, http.StatusNotFound, response.CodeNotFound, "error.email_suppression_not_found")
+	case errors.Is(err, services.ErrUnknownFeedbackProvider):
+		response.Error(c, http.StatusNotFound, response.CodeNotFound, "error.unknown_feedback_provider")
+	case errors.Is(err, services.ErrInvalidEmailAddress), errors.Is(err, email.ErrInvalidFeedback):
+		response.Error(c, http.StatusBadRequest, response.CodeValidationError, err.Error())
+	default:
+		_ = c.Error(err)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.internal")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/server"
+	"github.com/lusoris/venio/internal/stream"
+)
+
+// streamHeartbeat is how often an idle stream sends a comment so proxies
+// keep the connection open.
+const streamHeartbeat = 15 * time.Second
+
+// streamRetry is the reconnect delay suggested to clients, in milliseconds.
+const streamRetry = 3000
+
+// EventHandler streams domain events to clients.
+type EventHandler struct {
+	broker *stream.Broker
+}
+
+// NewEventHandler creates a new event handler.
+func NewEventHandler(broker *stream.Broker) *EventHandler {
+	return &EventHandler{broker: broker}
+}
+
+// Stream handles GET /api/v1/events as a server-sent events stream. Each
+// event is sent with its ID and type; ?types=a,b limits the stream to those
+// event types. Events relayed while a client is disconnected are not
+// replayed.
+func (h *EventHandler) Stream(c *gin.Context) {
+	var types []string
+	if value := c.Query("types"); value != "" {
+		types = strings.Split(value, ",")
+	}
+
+	events, unsubscribe := h.broker.Subscribe()
+	defer unsubscribe()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// Disable response buffering in nginx.
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	server.ClearWriteDeadline(c.Writer)
+
+	w := c.Writer
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", streamRetry); err != nil {
+		return
+	}
+	w.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		// Clients reconnect to another instance or after the restart.
+		case <-server.Draining(ctx):
+			return
+
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			w.Flush()
+
+		case event := <-events:
+			if len(types) > 0 && !slices.Contains(types, event.Type) {
+				continue
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return
+			}
+			w.Flush()
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/response"
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/services"
+)
+
+// InstanceHandler exposes CRUD endpoints for Arr and media server instances.
+type InstanceHandler struct {
+	service *services.InstanceService
+}
+
+// NewInstanceHandler creates a new instance handler.
+func NewInstanceHandler(service *services.InstanceService) *InstanceHandler {
+	return &InstanceHandler{service: service}
+}
+
+// List handles GET /api/v1/admin/instances.
+func (h *InstanceHandler) List(c *gin.Context) {
+	instances, err := h.service.List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.list_instances")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"instances": instances})
+}
+
+// Get handles GET /api/v1/admin/instances/:id.
+func (h *InstanceHandler) Get(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	instance, err := h.service.Get(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, instance)
+}
+
+// Create handles POST /api/v1/admin/instances.
+func (h *InstanceHandler) Create(c *gin.Context) {
+	var req models.CreateInstanceRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	instance, err := h.service.Create(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, instance)
+}
+
+// Update handles PUT /api/v1/admin/instances/:id.
+func (h *InstanceHandler) Update(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateInstanceRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	instance, err := h.service.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, instance)
+}
+
+// Delete handles DELETE /api/v1/admin/instances/:id.
+func (h *InstanceHandler) Delete(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// TestConnection handles POST /api/v1/admin/instances/:id/test. A failed
+// probe is reported in the body rather than as an HTTP error.
+func (h *InstanceHandler) TestConnection(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	err := h.service.TestConnection(c.Request.Context(), id)
+	if errors.Is(err, services.ErrInstanceNotFound) {
+		h.handleError(c, err)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{"success": false, "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// RefreshProfiles handles POST /api/v1/admin/instances/refresh-profiles.
+// The refresh runs in the background; the response carries the job to poll.
+func (h *InstanceHandler) RefreshProfiles(c *gin.Context) {
+	job, err := h.service.RefreshAllProfiles(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.start_profile_refresh")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, job)
+}
+
+// handleError maps service errors to HTTP responses.
+func (h *InstanceHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrInstanceNotFound):
+		response.Error(c, http.StatusNotFound, response.CodeNotFound, "error.instance_not_found")
+	case errors.Is(err, services.ErrInstanceExists):
+		response.Error(c, http.StatusConflict, response.CodeConflict, "error.instance_exists")
+	case errors.Is(err, services.ErrInvalidInstanceType):
+		response.Error(c, http.StatusBadRequest, response.CodeValidationError, "error.invalid_instance_type")
+	default:
+		_ = c.Error(err)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.internal")
+	}
+}
+
+// parseIDParam parses the ":id" path parameter, writing a 400 response and
+// returning false when it is not a positive integer.
+func parseIDParam(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.Error(c, http.StatusBadRequest, response.CodeValidationError, "error.invalid_id")
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/response"
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/services"
+)
+
+// defaultJobQueue is the queue of the dead job endpoints when none is given.
+const defaultJobQueue = "default"
+
+// JobHandler exposes background job administration endpoints.
+type JobHandler struct {
+	service  *services.JobService
+	pageSize config.PageSize
+}
+
+// NewJobHandler creates a new job handler whose listings return pageSize
+// runs or jobs per page.
+func NewJobHandler(service *services.JobService, pageSize config.PageSize) *JobHandler {
+	return &JobHandler{service: service, pageSize: pageSize}
+}
+
+// GetStatus handles GET /api/v1/jobs/:id.
+func (h *JobHandler) GetStatus(c *gin.Context) {
+	status, err := h.service.GetStatus(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// ListQueues handles GET /api/v1/admin/jobs/queues.
+func (h *JobHandler) ListQueues(c *gin.Context) {
+	queues, err := h.service.ListQueues()
+	if err != nil {
+		_ = c.Error(err)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.list_queues")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"queues": queues})
+}
+
+// ListHistory handles GET /api/v1/admin/jobs/history.
+// Query parameters: type, status (succeeded|failed), since (RFC 3339),
+// page, per_page.
+func (h *JobHandler) ListHistory(c *gin.Context) {
+	page, perPage := h.pagination(c)
+
+	filter := models.JobRunFilter{
+		Type:   c.Query("type"),
+		Status: models.JobRunStatus(c.Query("status")),
+	}
+	if filter.Status != "" && !filter.Status.Valid() {
+		response.Error(c, http.StatusBadRequest, response.CodeValidationError, "error.invalid_job_status")
+		return
+	}
+	if since := c.Query("since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, response.CodeValidationError, "error.invalid_since")
+			return
+		}
+		filter.Since = t
+	}
+
+	runs, err := h.service.ListHistory(c.Request.Context(), filter, page, perPage)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"runs": runs, "page": page, "per_page": perPage})
+}
+
+// ListDead handles GET /api/v1/admin/jobs/dead.
+// Query parameters: queue (default "default"), page, per_page.
+func (h *JobHandler) ListDead(c *gin.Context) {
+	page, perPage := h.pagination(c)
+
+	jobs, err := h.service.ListDead(c.DefaultQuery("queue", defaultJobQueue), page, perPage)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"jobs": jobs, "page": page, "per_page": perPage})
+}
+
+// RequeueDead handles POST /api/v1/admin/jobs/dead/:id/requeue.
+func (h *JobHandler) RequeueDead(c *gin.Context) {
+	if err := h.service.RequeueDead(c.DefaultQuery("queue", defaultJobQueue), c.Param("id")); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Job requeued"})
+}
+
+// DeleteDead handles DELETE /api/v1/admin/jobs/dead/:id.
+func (h *JobHandler) DeleteDead(c *gin.Context) {
+	if err := h.service.DeleteDead(c.DefaultQuery("queue", defaultJobQueue), c.Param("id")); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// pagination reads the page and per_page query parameters, falling back
+// to the defaults for missing or out-of-range values.
+func (h *JobHandler) pagination(c *gin.Context) (page, perPage int) {
+	page, _ = strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page < 1 {
+		page = 1
+	}
+	return page, h.pageSize.Of(c.Query("per_page"))
+}
+
+// handleError maps service errors to HTTP responses.
+func (h *JobHandler) handleError(c *gin.Context, err error) {
+	if errors.Is(err, services.ErrJobNotFound) {
+		response.Error(c, http.StatusNotFound, response.CodeNotFound, "error.job_not_found")
+		return
+	}
+	_ = c.Error(err)
+	response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.internal")
+}
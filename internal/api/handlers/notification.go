@@ -0,0 +1,145 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/response"
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/services"
+)
+
+// NotificationHandler exposes CRUD endpoints for notification agents.
+type NotificationHandler struct {
+	service *services.NotificationService
+}
+
+// NewNotificationHandler creates a new notification handler.
+func NewNotificationHandler(service *services.NotificationService) *NotificationHandler {
+	return &NotificationHandler{service: service}
+}
+
+// List handles GET /api/v1/admin/notifications/agents.
+func (h *NotificationHandler) List(c *gin.Context) {
+	agents, err := h.service.List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.list_notification_agents")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"agents": agents})
+}
+
+// Get handles GET /api/v1/admin/notifications/agents/:id.
+func (h *NotificationHandler) Get(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	agent, err := h.service.Get(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, agent)
+}
+
+// Create handles POST /api/v1/admin/notifications/agents.
+func (h *NotificationHandler) Create(c *gin.Context) {
+	var req models.CreateNotificationAgentRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	agent, err := h.service.Create(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, agent)
+}
+
+// Update handles PUT /api/v1/admin/notifications/agents/:id.
+func (h *NotificationHandler) Update(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateNotificationAgentRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	agent, err := h.service.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, agent)
+}
+
+// Delete handles DELETE /api/v1/admin/notifications/agents/:id.
+func (h *NotificationHandler) Delete(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Test handles POST /api/v1/admin/notifications/agents/:id/test. A failed
+// send is reported in the body rather than as an HTTP error.
+func (h *NotificationHandler) Test(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	err := h.service.Test(c.Request.Context(), id)
+	if errors.Is(err, services.ErrNotificationAgentNotFound) {
+		h.handleError(c, err)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{"success": false, "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// handleError maps service errors to HTTP responses.
+func (h *NotificationHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrNotificationAgentNotFound):
+		response.Error(c, http.StatusNotFound, response.CodeNotFound, "error.notification_agent_not_found")
+	case errors.Is(err, services.ErrNotificationAgentExists):
+		response.Error(c, http.StatusConflict, response.CodeConflict, "error.notification_agent_exists")
+	case errors.Is(err, services.ErrInvalidNotificationAgent):
+		response.Error(c, http.StatusBadRequest, response.CodeValidationError, err.Error())
+	default:
+		_ = c.Error(err)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.internal")
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/lusoris/venio/internal/api/response"
+	"github.com/lusoris/venio/internal/i18n"
+)
+
+func init() {
+	// Report invalid fields by the names clients send them under.
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(jsonFieldName)
+	}
+}
+
+// jsonFieldName returns the JSON name of a struct field.
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
+
+// bindJSON decodes the JSON request body into obj, writing a 413 response
+// when the body exceeds the limit and a validation error otherwise.
+func bindJSON(c *gin.Context, obj any) bool {
+	err := c.ShouldBindJSON(obj)
+	if err == nil {
+		return true
+	}
+
+	if !bodyTooLarge(c, err) {
+		invalidBody(c, err)
+	}
+	return false
+}
+
+// bodyTooLarge writes a 413 response and returns true when err comes from
+// reading past the body limit.
+func bodyTooLarge(c *gin.Context, err error) bool {
+	var tooLarge *http.MaxBytesError
+	if !errors.As(err, &tooLarge) {
+		return false
+	}
+	response.Error(c, http.StatusRequestEntityTooLarge, response.CodePayloadTooLarge,
+		"error.payload_too_large", "limit", tooLarge.Limit)
+	return true
+}
+
+// invalidBody writes a 400 response for a request body that could not be
+// decoded or failed validation. Invalid fields are listed with a message in
+// the request's locale, looked up as "validation.<tag>".
+func invalidBody(c *gin.Context, err error) {
+	l := i18n.FromContext(c.Request.Context())
+
+	var (
+		validationErrs validator.ValidationErrors
+		typeErr        *json.UnmarshalTypeError
+		syntaxErr      *json.SyntaxError
+	)
+	switch {
+	case errors.As(err, &validationErrs):
+		fields := make(map[string]string, len(validationErrs))
+		for _, fe := range validationErrs {
+			key := "validation." + fe.Tag()
+			if !l.Has(key) {
+				key = "validation.invalid"
+			}
+			fields[fe.Field()] = l.T(key, "field", fe.Field(), "param", fe.Param())
+		}
+		response.ValidationError(c, fields)
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		response.ValidationError(c, map[string]string{typeErr.Field: l.T("validation.type", "field", typeErr.Field)})
+	case errors.Is(err, io.EOF):
+		response.Error(c, http.StatusBadRequest, response.CodeValidationError, "error.empty_body")
+	case errors.As(err, &syntaxErr), errors.As(err, &typeErr), errors.Is(err, io.ErrUnexpectedEOF):
+		response.Error(c, http.StatusBadRequest, response.CodeValidationError, "error.invalid_json")
+	default:
+		response.Error(c, http.StatusBadRequest, response.CodeValidationError, err.Error())
+	}
+}
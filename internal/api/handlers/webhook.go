@@ -0,0 +1,173 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/response"
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/services"
+)
+
+// WebhookHandler exposes CRUD endpoints for outgoing webhooks.
+type WebhookHandler struct {
+	service       *services.WebhookService
+	deliveryLimit config.PageSize
+}
+
+// NewWebhookHandler creates a new webhook handler whose delivery listing
+// returns up to deliveryLimit attempts.
+func NewWebhookHandler(service *services.WebhookService, deliveryLimit config.PageSize) *WebhookHandler {
+	return &WebhookHandler{service: service, deliveryLimit: deliveryLimit}
+}
+
+// List handles GET /api/v1/admin/webhooks.
+func (h *WebhookHandler) List(c *gin.Context) {
+	webhooks, err := h.service.List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.list_webhooks")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"webhooks": webhooks})
+}
+
+// Get handles GET /api/v1/admin/webhooks/:id.
+func (h *WebhookHandler) Get(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	webhook, err := h.service.Get(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+// Create handles POST /api/v1/admin/webhooks.
+func (h *WebhookHandler) Create(c *gin.Context) {
+	var req models.CreateWebhookRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	webhook, err := h.service.Create(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhook)
+}
+
+// Update handles PUT /api/v1/admin/webhooks/:id.
+func (h *WebhookHandler) Update(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateWebhookRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	webhook, err := h.service.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+// Delete handles DELETE /api/v1/admin/webhooks/:id.
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Deliveries handles GET /api/v1/admin/webhooks/:id/deliveries.
+// Query parameters: limit (default 50, max 500; see LIST_PAGE_SIZES).
+func (h *WebhookHandler) Deliveries(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+
+	limit := h.deliveryLimit.Of(c.Query("limit"))
+
+	deliveries, err := h.service.Deliveries(c.Request.Context(), id, limit)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deliveries": deliveries})
+}
+
+// Redeliver handles POST /api/v1/admin/webhooks/:id/deliveries/:deliveryId/redeliver.
+// The delivery runs in the background; its outcome appears in the delivery
+// history.
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	id, ok := parseIDParam(c)
+	if !ok {
+		return
+	}
+	deliveryID, err := strconv.ParseInt(c.Param("deliveryId"), 10, 64)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, response.CodeValidationError, "error.invalid_delivery_id")
+		return
+	}
+
+	if err := h.service.Redeliver(c.Request.Context(), id, deliveryID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// handleError maps service errors to HTTP responses.
+func (h *WebhookHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrWebhookNotFound):
+		response.Error(c, http.StatusNotFound, response.CodeNotFound, "error.webhook_not_found")
+	case errors.Is(err, services.ErrWebhookDeliveryNotFound):
+		response.Error(c, http.StatusNotFound, response.CodeNotFound, "error.webhook_delivery_not_found")
+	case errors.Is(err, services.ErrWebhookExists):
+		response.Error(c, http.StatusConflict, response.CodeConflict, "error.webhook_exists")
+	case errors.Is(err, services.ErrWebhookDisabled):
+		response.Error(c, http.StatusConflict, response.CodeConflict, "error.webhook_disabled")
+	case errors.Is(err, services.ErrWebhookPayloadMissing):
+		response.Error(c, http.StatusConflict, response.CodeConflict, "error.webhook_payload_missing")
+	default:
+		_ = c.Error(err)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.internal")
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package handlers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+
+	"github.com/lusoris/venio/internal/api/middleware"
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/events"
+	"github.com/lusoris/venio/internal/server"
+	"github.com/lusoris/venio/internal/stream"
+)
+
+// WebSocket timing.
+const (
+	// wsAuthTimeout is how long a client may take to authenticate.
+	wsAuthTimeout = 10 * time.Second
+	// wsHeartbeat is how often the server sends a ping message.
+	wsHeartbeat = 15 * time.Second
+	// wsIdleTimeout closes connections that stopped sending anything,
+	// including pongs.
+	wsIdleTimeout = 2*wsHeartbeat + 5*time.Second
+	// wsWriteTimeout bounds a single write to a client.
+	wsWriteTimeout = 10 * time.Second
+)
+
+// WebSocket message types.
+const (
+	wsTypeAuth         = "auth"
+	wsTypeReady        = "ready"
+	wsTypeSubscribe    = "subscribe"
+	wsTypeSubscribed   = "subscribed"
+	wsTypeUnsubscribe  = "unsubscribe"
+	wsTypeUnsubscribed = "unsubscribed"
+	wsTypeEvent        = "event"
+	wsTypePing         = "ping"
+	wsTypePong         = "pong"
+	wsTypeError        = "error"
+)
+
+// wsClientMessage is a message sent by a client.
+type wsClientMessage struct {
+	Type   string   `json:"type"`
+	APIKey string   `json:"api_key,omitempty"`
+	Topics []string `json:"topics,omitempty"`
+	// LastEventID replays the matching events published after it.
+	LastEventID *int64 `json:"last_event_id,omitempty"`
+}
+
+// wsServerMessage is a message sent to a client.
+type wsServerMessage struct {
+	Type    string        `json:"type"`
+	Topics  []string      `json:"topics,omitempty"`
+	Event   *events.Event `json:"event,omitempty"`
+	Message string        `json:"message,omitempty"`
+}
+
+// WebSocketHandler streams domain events over WebSocket, as an
+// alternative to the server-sent events stream for interactive clients.
+type WebSocketHandler struct {
+	broker *stream.Broker
+	apiKey *config.Value[string]
+}
+
+// NewWebSocketHandler creates a new WebSocket handler.
+func NewWebSocketHandler(broker *stream.Broker, apiKey *config.Value[string]) *WebSocketHandler {
+	return &WebSocketHandler{broker: broker, apiKey: apiKey}
+}
+
+// Connect handles GET /api/v1/ws. Browsers cannot set headers on a
+// WebSocket handshake, so clients authenticate with an "auth" message
+// unless the handshake carried a valid X-Api-Key header.
+func (h *WebSocketHandler) Connect(c *gin.Context) {
+	authenticated := middleware.ValidAPIKey(h.apiKey.Load(), c.GetHeader(middleware.APIKeyHeader))
+	ctx := c.Request.Context()
+
+	// websocket.Server skips the Origin check of websocket.Handler. Clients
+	// authenticate with the API key rather than cookies, so other sites
+	// cannot act on a user's behalf.
+	server := websocket.Server{Handler: func(ws *websocket.Conn) {
+		h.serve(ctx, ws, authenticated)
+	}}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// serve runs one connection until the client leaves or fails to keep up
+// with the protocol.
+func (h *WebSocketHandler) serve(parent context.Context, ws *websocket.Conn, authenticated bool) {
+	defer ws.Close()
+
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	incoming := make(chan wsClientMessage)
+	go func() {
+		defer cancel()
+		for {
+			if err := ws.SetReadDeadline(time.Now().Add(wsIdleTimeout)); err != nil {
+				return
+			}
+			var msg wsClientMessage
+			if err := websocket.JSON.Receive(ws, &msg); err != nil {
+				return
+			}
+			select {
+			case incoming <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	send := func(msg wsServerMessage) bool {
+		if err := ws.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+			return false
+		}
+		return websocket.JSON.Send(ws, msg) == nil
+	}
+	fail := func(message string) {
+		send(wsServerMessage{Type: wsTypeError, Message: message})
+	}
+
+	// live stays nil, and therefore silent, until the client is
+	// authenticated.
+	var live <-chan *events.Event
+	start := func() bool {
+		ch, unsubscribe := h.broker.Subscribe()
+		go func() {
+			<-ctx.Done()
+			unsubscribe()
+		}()
+		live = ch
+		return send(wsServerMessage{Type: wsTypeReady})
+	}
+	if authenticated && !start() {
+		return
+	}
+
+	authTimer := time.NewTimer(wsAuthTimeout)
+	defer authTimer.Stop()
+	heartbeat := time.NewTicker(wsHeartbeat)
+	defer heartbeat.Stop()
+
+	topics := make(map[string]bool)
+	// replayed holds replayed event IDs so the live copy is not sent again.
+	replayed := make(map[int64]bool)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-server.Draining(ctx):
+			return
+
+		case <-authTimer.C:
+			if !authenticated {
+				fail("authentication timed out")
+				return
+			}
+
+		case <-heartbeat.C:
+			if !send(wsServerMessage{Type: wsTypePing}) {
+				return
+			}
+
+		case event := <-live:
+			if replayed[event.ID] || !matchesTopics(topics, event.Type) {
+				continue
+			}
+			if !send(wsServerMessage{Type: wsTypeEvent, Event: event}) {
+				return
+			}
+
+		case msg := <-incoming:
+			if msg.Type == wsTypeAuth {
+				if authenticated {
+					continue
+				}
+				if !middleware.ValidAPIKey(h.apiKey.Load(), msg.APIKey) {
+					fail("invalid API key")
+					return
+				}
+				authenticated = true
+				if !start() {
+					return
+				}
+				continue
+			}
+			if !authenticated {
+				fail("authenticate first")
+				return
+			}
+
+			switch msg.Type {
+			case wsTypeSubscribe:
+				for _, topic := range msg.Topics {
+					topics[topic] = true
+				}
+				if !send(wsServerMessage{Type: wsTypeSubscribed, Topics: msg.Topics}) {
+					return
+				}
+				if msg.LastEventID != nil && !h.replay(ctx, *msg.LastEventID, topics, replayed, send) {
+					return
+				}
+
+			case wsTypeUnsubscribe:
+				for _, topic := range msg.Topics {
+					delete(topics, topic)
+				}
+				if !send(wsServerMessage{Type: wsTypeUnsubscribed, Topics: msg.Topics}) {
+					return
+				}
+
+			case wsTypePong:
+
+			default:
+				if !send(wsServerMessage{Type: wsTypeError, Message: "unknown message type " + msg.Type}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// replay sends the events after afterID that match topics, so a
+// reconnecting client catches up. It reports false when the connection
+// failed.
+func (h *WebSocketHandler) replay(
+	ctx context.Context,
+	afterID int64,
+	topics map[string]bool,
+	replayed map[int64]bool,
+	send func(wsServerMessage) bool,
+) bool {
+	missed, err := h.broker.Replay(ctx, afterID)
+	if err != nil {
+		return send(wsServerMessage{Type: wsTypeError, Message: "failed to replay events"})
+	}
+
+	for _, event := range missed {
+		if !matchesTopics(topics, event.Type) {
+			continue
+		}
+		replayed[event.ID] = true
+		if !send(wsServerMessage{Type: wsTypeEvent, Event: event}) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesTopics reports whether eventType matches one of the topics: "*"
+// matches everything, "instance.*" matches a prefix, and any other topic
+// matches one event type.
+func matchesTopics(topics map[string]bool, eventType string) bool {
+	if topics["*"] || topics[eventType] {
+		return true
+	}
+	for topic := range topics {
+		if prefix, ok := strings.CutSuffix(topic, "*"); ok && strings.HasPrefix(eventType, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package middleware contains Gin middleware used by the API router.
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/response"
+	"github.com/lusoris/venio/internal/config"
+)
+
+// APIKeyHeader is the header carrying the static API key.
+const APIKeyHeader = "X-Api-Key"
+
+// RequireAPIKey rejects requests that do not present the configured API key,
+// which is read per request so a rotated key applies at once. When no key
+// is configured every request is rejected, so protected routes stay closed
+// by default. Accepted requests are audited as "api_key".
+func RequireAPIKey(key *config.Value[string]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKey := key.Load()
+		if apiKey == "" {
+			response.Error(c, http.StatusForbidden, response.CodeForbidden, "error.api_key_not_configured")
+			return
+		}
+
+		if !ValidAPIKey(apiKey, c.GetHeader(APIKeyHeader)) {
+			response.Error(c, http.StatusUnauthorized, response.CodeUnauthorized, "error.invalid_api_key")
+			return
+		}
+
+		setActor(c, "api_key")
+		c.Next()
+	}
+}
+
+// ValidAPIKey reports whether provided matches the configured API key. An
+// unset key matches nothing.
+func ValidAPIKey(apiKey, provided string) bool {
+	return apiKey != "" && subtle.ConstantTimeCompare([]byte(provided), []byte(apiKey)) == 1
+}
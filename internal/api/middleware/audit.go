@@ -0,0 +1,121 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/audit"
+)
+
+// ActorKey is the Gin context key naming who made the request, for the
+// audit log. Authentication middleware sets it with setActor.
+const ActorKey = "actor"
+
+// skipAuditKey marks a request that is not audited; see NoAudit.
+const skipAuditKey = "audit_skip"
+
+// maxAuditBody is the most of a request body kept for the audit log.
+const maxAuditBody = 64 << 10
+
+// AuditOptions configures the Audit middleware.
+type AuditOptions struct {
+	// LogBodies records request bodies, with RedactFields replaced.
+	LogBodies    bool
+	RedactFields []string
+}
+
+// Audit records every POST, PUT, PATCH, and DELETE request with its actor,
+// route, status, latency, and request ID once it has been handled. Routes
+// opt out with NoAudit. It must run after RequestID and before BodyLimit,
+// so that it sees the body as the handler reads it.
+func Audit(logger audit.Logger, opts AuditOptions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			c.Next()
+			return
+		}
+
+		var body *auditBody
+		if opts.LogBodies && c.Request.Body != nil {
+			body = &auditBody{ReadCloser: c.Request.Body}
+			c.Request.Body = body
+		}
+
+		start := time.Now()
+		c.Next()
+
+		if c.GetBool(skipAuditKey) {
+			return
+		}
+
+		entry := &audit.Entry{
+			Time:      start,
+			Action:    audit.ActionRequest,
+			Actor:     c.GetString(ActorKey),
+			ClientIP:  c.ClientIP(),
+			Method:    c.Request.Method,
+			Route:     c.FullPath(),
+			Path:      c.Request.URL.Path,
+			Status:    c.Writer.Status(),
+			Latency:   time.Since(start),
+			RequestID: c.GetString(RequestIDKey),
+		}
+		if entry.Actor == "" {
+			entry.Actor = "anonymous"
+		}
+		if body != nil && !body.truncated && body.buf.Len() > 0 {
+			entry.Body = audit.Redact(body.buf.Bytes(), opts.RedactFields)
+		}
+		logger.Log(c.Request.Context(), entry)
+	}
+}
+
+// setActor records who made the request, in the Gin context for Audit and
+// in the request context for entries recorded by services.
+func setActor(c *gin.Context, actor string) {
+	c.Set(ActorKey, actor)
+	c.Request = c.Request.WithContext(audit.WithActor(c.Request.Context(), actor))
+}
+
+// NoAudit keeps a route out of the audit log, e.g. because its requests
+// come from machines and would drown out the actions of people.
+func NoAudit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(skipAuditKey, true)
+		c.Next()
+	}
+}
+
+// auditBody keeps a copy of the request body as the handler reads it.
+// Bodies larger than maxAuditBody are not kept.
+type auditBody struct {
+	io.ReadCloser
+	buf       bytes.Buffer
+	truncated bool
+}
+
+func (b *auditBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if !b.truncated {
+		if b.buf.Len()+n > maxAuditBody {
+			b.truncated = true
+			b.buf.Reset()
+		} else {
+			b.buf.Write(p[:n])
+		}
+	}
+	return n, err
+}
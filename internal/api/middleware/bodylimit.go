@@ -0,0 +1,38 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// originalBodyKey holds the request body before the first BodyLimit wrapped
+// it, so a route group can replace the global limit.
+const originalBodyKey = "body_limit_original"
+
+// BodyLimit caps the request body at limit bytes: reading past the limit
+// fails with *http.MaxBytesError, which handlers answer with 413 (see
+// handlers.bindJSON). A later BodyLimit, e.g. on a single route, replaces
+// an earlier one instead of adding to it, so the check happens on read
+// rather than against Content-Length up front.
+func BodyLimit(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		body := c.Request.Body
+		if original, ok := c.Get(originalBodyKey); ok {
+			body = original.(io.ReadCloser)
+		} else {
+			c.Set(originalBodyKey, body)
+		}
+		c.Request.Body = http.MaxBytesReader(c.Writer, body, limit)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/audit"
+)
+
+// BodyLogOptions configures the BodyLog middleware.
+type BodyLogOptions struct {
+	// SampleRate is the share of requests, from 0 to 1, whose bodies are
+	// logged. Requests to Routes, given as route patterns, always are.
+	SampleRate float64
+	Routes     []string
+	// MaxSize is the largest body, in bytes, that is logged.
+	MaxSize int
+	// RedactFields are replaced in logged bodies.
+	RedactFields []string
+}
+
+// BodyLog logs the request and response bodies of sampled requests, to
+// debug client integrations. Only JSON bodies up to MaxSize are logged,
+// with RedactFields replaced; of others only the size is logged, since
+// they cannot be redacted. Event streams and WebSocket connections are not
+// logged. It must run after RequestID.
+func BodyLog(opts BodyLogOptions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !slices.Contains(opts.Routes, c.FullPath()) && (opts.SampleRate <= 0 || rand.Float64() >= opts.SampleRate) {
+			c.Next()
+			return
+		}
+
+		var request *cappedBody
+		if c.Request.Body != nil {
+			request = &cappedBody{ReadCloser: c.Request.Body, buf: cappedBuffer{limit: opts.MaxSize}}
+			c.Request.Body = request
+		}
+		response := &capturingWriter{ResponseWriter: c.Writer, body: cappedBuffer{limit: opts.MaxSize}}
+		c.Writer = response
+
+		c.Next()
+
+		if streaming(c) {
+			return
+		}
+		requestBody := "-"
+		if request != nil {
+			requestBody = request.buf.describe(opts.RedactFields)
+		}
+		log.Printf("Bodies of %s %s -> %d (request %s)\n  request: %s\n  response: %s",
+			c.Request.Method, c.Request.URL.Path, c.Writer.Status(), c.GetString(RequestIDKey),
+			requestBody, response.body.describe(opts.RedactFields))
+	}
+}
+
+// cappedBuffer keeps up to limit bytes and counts the rest.
+type cappedBuffer struct {
+	buf   bytes.Buffer
+	limit int
+	size  int
+}
+
+func (b *cappedBuffer) write(p []byte) {
+	b.size += len(p)
+	if b.size <= b.limit {
+		b.buf.Write(p)
+	}
+}
+
+// describe returns the redacted body, or its size when it is empty, too
+// large, or not JSON.
+func (b *cappedBuffer) describe(redactFields []string) string {
+	switch {
+	case b.size == 0:
+		return "-"
+	case b.size > b.limit:
+		return fmt.Sprintf("(%d bytes, too large to log)", b.size)
+	}
+	if body := audit.Redact(b.buf.Bytes(), redactFields); body != nil {
+		return string(body)
+	}
+	return fmt.Sprintf("(%d bytes, not JSON)", b.size)
+}
+
+// cappedBody keeps a copy of the request body as the handler reads it.
+type cappedBody struct {
+	io.ReadCloser
+	buf cappedBuffer
+}
+
+func (b *cappedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.buf.write(p[:n])
+	return n, err
+}
+
+// capturingWriter keeps a copy of the response body as it is written.
+type capturingWriter struct {
+	gin.ResponseWriter
+	body cappedBuffer
+}
+
+func (w *capturingWriter) Write(p []byte) (int, error) {
+	w.body.write(p)
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *capturingWriter) WriteString(s string) (int, error) {
+	w.body.write([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Unwrap lets http.ResponseController reach the connection.
+func (w *capturingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/response"
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/requestid"
+)
+
+// exposedHeaders lists the response headers browsers let scripts read.
+var exposedHeaders = strings.Join([]string{
+	requestid.Header,
+	"Retry-After",
+	"X-RateLimit-Limit",
+	"X-RateLimit-Remaining",
+	"X-RateLimit-Reset",
+}, ", ")
+
+// CORS answers preflight requests and adds CORS headers for the origins in
+// cfg, which is read per request so reloaded origins apply at once.
+// Requests from other origins get no CORS headers, so browsers block them;
+// their preflight requests are rejected with 403. Without allowed origins
+// the middleware does nothing.
+func CORS(value *config.Value[config.CORSConfig]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cfg := value.Load()
+		origin := c.GetHeader("Origin")
+		if origin == "" || len(cfg.AllowedOrigins) == 0 {
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Origin")
+		preflight := c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != ""
+
+		if !originAllowed(cfg.AllowedOrigins, origin) {
+			if preflight {
+				response.Error(c, http.StatusForbidden, response.CodeForbidden, "error.origin_not_allowed")
+				return
+			}
+			c.Next()
+			return
+		}
+
+		h := c.Writer.Header()
+		h.Set("Access-Control-Allow-Origin", origin)
+		if cfg.AllowCredentials {
+			h.Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		if preflight {
+			h.Set("Access-Control-Allow-Methods", strings.Join(cfg.AllowedMethods, ", "))
+			h.Set("Access-Control-Allow-Headers", strings.Join(cfg.AllowedHeaders, ", "))
+			h.Set("Access-Control-Max-Age", strconv.Itoa(int(cfg.MaxAge.Seconds())))
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		h.Set("Access-Control-Expose-Headers", exposedHeaders)
+		c.Next()
+	}
+}
+
+// originAllowed reports whether origin matches one of the allowed origins.
+// "https://*.example.com" matches subdomains of example.com at any depth,
+// but not example.com itself.
+func originAllowed(allowed []string, origin string) bool {
+	origin = strings.ToLower(origin)
+	for _, pattern := range allowed {
+		pattern = strings.ToLower(strings.TrimSuffix(pattern, "/"))
+		if pattern == "*" || pattern == origin {
+			return true
+		}
+
+		scheme, host, ok := strings.Cut(pattern, "://*.")
+		if !ok {
+			continue
+		}
+		rest, ok := strings.CutPrefix(origin, scheme+"://")
+		if ok && strings.HasSuffix(rest, "."+host) && len(rest) > len(host)+1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import "testing"
+
+func TestOriginAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		origin  string
+		want    bool
+	}{
+		{"none allowed", nil, "https://example.com", false},
+		{"any", []string{"*"}, "https://example.com", true},
+		{"exact", []string{"https://example.com"}, "https://example.com", true},
+		{"case", []string{"https://Example.com"}, "HTTPS://EXAMPLE.COM", true},
+		{"trailing slash", []string{"https://example.com/"}, "https://example.com", true},
+		{"other host", []string{"https://example.com"}, "https://example.org", false},
+		{"other scheme", []string{"https://example.com"}, "http://example.com", false},
+		{"other port", []string{"https://example.com"}, "https://example.com:8443", false},
+		{"suffix is not a subdomain", []string{"https://example.com"}, "https://evilexample.com", false},
+		{"wildcard subdomain", []string{"https://*.example.com"}, "https://app.example.com", true},
+		{"wildcard nested subdomain", []string{"https://*.example.com"}, "https://a.b.example.com", true},
+		{"wildcard bare host", []string{"https://*.example.com"}, "https://example.com", false},
+		{"wildcard empty label", []string{"https://*.example.com"}, "https://.example.com", false},
+		{"wildcard other scheme", []string{"https://*.example.com"}, "http://app.example.com", false},
+		{"wildcard lookalike", []string{"https://*.example.com"}, "https://app.evilexample.com", false},
+		{"wildcard suffix attack", []string{"https://*.example.com"}, "https://app.example.com.evil.io", false},
+		{"second pattern", []string{"https://example.org", "https://*.example.com"}, "https://app.example.com", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := originAllowed(tt.allowed, tt.origin); got != tt.want {
+				t.Errorf("originAllowed(%q, %q) = %v, want %v", tt.allowed, tt.origin, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/errortracking"
+)
+
+// ReportErrors reports responses with a 5xx status to reporter, with the
+// error the handler attached with c.Error if there is one. Requests the
+// client gave up on are not reported. It must run after Recovery, which
+// reports panics itself.
+func ReportErrors(reporter errortracking.Reporter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		status := c.Writer.Status()
+		if status < http.StatusInternalServerError || errors.Is(c.Request.Context().Err(), context.Canceled) {
+			return
+		}
+
+		err := errors.New(http.StatusText(status))
+		if last := c.Errors.Last(); last != nil {
+			err = last.Err
+		}
+		reportRequestError(c, reporter, errortracking.LevelError, err, nil)
+	}
+}
+
+// reportRequestError reports err, which happened during the request, with
+// the route, request ID, and caller.
+func reportRequestError(c *gin.Context, reporter errortracking.Reporter, level string, err error, stack []errortracking.Frame) {
+	tags := map[string]string{
+		"request_id": c.GetString(RequestIDKey),
+		"status":     fmt.Sprint(c.Writer.Status()),
+	}
+	if route := c.FullPath(); route != "" {
+		tags["route"] = route
+	}
+	reporter.Report(c.Request.Context(), &errortracking.Event{
+		Err:     err,
+		Level:   level,
+		Tags:    tags,
+		User:    &errortracking.User{ID: c.GetString(ActorKey), IPAddress: c.ClientIP()},
+		Request: &errortracking.Request{Method: c.Request.Method, URL: c.Request.URL.Path},
+		Stack:   stack,
+	})
+}
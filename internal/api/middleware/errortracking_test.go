@@ -0,0 +1,109 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/errortracking"
+)
+
+// recordingReporter keeps the events reported to it.
+type recordingReporter struct {
+	mu     sync.Mutex
+	events []*errortracking.Event
+}
+
+func (r *recordingReporter) Report(_ context.Context, e *errortracking.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func TestErrorReporting(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	errDatabase := errors.New("database is down")
+
+	tests := []struct {
+		name      string
+		handler   gin.HandlerFunc
+		wantLevel string
+		wantErr   string
+	}{
+		{
+			name:      "panic",
+			handler:   func(*gin.Context) { panic("boom") },
+			wantLevel: errortracking.LevelFatal,
+			wantErr:   "panic: boom",
+		},
+		{
+			name: "server error with cause",
+			handler: func(c *gin.Context) {
+				_ = c.Error(errDatabase)
+				c.AbortWithStatus(http.StatusInternalServerError)
+			},
+			wantLevel: errortracking.LevelError,
+			wantErr:   errDatabase.Error(),
+		},
+		{
+			name:      "server error without cause",
+			handler:   func(c *gin.Context) { c.AbortWithStatus(http.StatusBadGateway) },
+			wantLevel: errortracking.LevelError,
+			wantErr:   http.StatusText(http.StatusBadGateway),
+		},
+		{
+			name:    "client error",
+			handler: func(c *gin.Context) { c.AbortWithStatus(http.StatusNotFound) },
+		},
+		{
+			name:    "success",
+			handler: func(c *gin.Context) { c.Status(http.StatusOK) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reporter := &recordingReporter{}
+			router := gin.New()
+			router.Use(RequestID(), Recovery(reporter), ReportErrors(reporter))
+			router.GET("/items/:id", tt.handler)
+
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/items/1?token=secret", nil))
+
+			if tt.wantErr == "" {
+				if len(reporter.events) != 0 {
+					t.Fatalf("reported %d events, want none", len(reporter.events))
+				}
+				return
+			}
+			if len(reporter.events) != 1 {
+				t.Fatalf("reported %d events, want 1", len(reporter.events))
+			}
+			e := reporter.events[0]
+			if e.Level != tt.wantLevel || e.Err == nil || e.Err.Error() != tt.wantErr {
+				t.Errorf("event = %s %v, want %s %s", e.Level, e.Err, tt.wantLevel, tt.wantErr)
+			}
+			if e.Tags["route"] != "/items/:id" || e.Tags["request_id"] == "" {
+				t.Errorf("tags = %v, want the route and request ID", e.Tags)
+			}
+			if e.Request == nil || e.Request.URL != "/items/1" {
+				t.Errorf("request = %+v, want the path without its query", e.Request)
+			}
+			if (len(e.Stack) > 0) != (tt.wantLevel == errortracking.LevelFatal) {
+				t.Errorf("stack has %d frames, want one only for panics", len(e.Stack))
+			}
+		})
+	}
+}
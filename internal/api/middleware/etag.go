@@ -0,0 +1,114 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ETag tags successful GET responses with a hash of their body and answers
+// 304 Not Modified when the client's If-None-Match already names it, so
+// polling clients download unchanged data only once. The response is
+// buffered, so streaming routes must not use it.
+func ETag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		w := &bufferedWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		if w.body.Len() == 0 {
+			return
+		}
+
+		header := c.Writer.Header()
+		etag := header.Get("ETag")
+		if etag == "" {
+			sum := sha256.Sum256(w.body.Bytes())
+			etag = `"` + hex.EncodeToString(sum[:16]) + `"`
+			header.Set("ETag", etag)
+		}
+		if header.Get("Cache-Control") == "" {
+			// Responses depend on credentials, so only the client may keep
+			// them, and it must revalidate before reuse.
+			header.Set("Cache-Control", "private, no-cache")
+		}
+
+		if etagMatches(c.GetHeader("If-None-Match"), etag) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			c.Writer.WriteHeader(http.StatusNotModified)
+			c.Writer.WriteHeaderNow()
+			return
+		}
+		_, _ = c.Writer.Write(w.body.Bytes())
+	}
+}
+
+// bufferedWriter holds back the body of a 200 response until the handler is
+// done, so it can be inspected before it is sent. Other responses are
+// written through.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// buffering reports whether writes go to the buffer.
+func (w *bufferedWriter) buffering() bool {
+	return w.body.Len() > 0 || (w.ResponseWriter.Status() == http.StatusOK && !w.ResponseWriter.Written())
+}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	if w.buffering() {
+		return w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	if w.buffering() {
+		return w.body.WriteString(s)
+	}
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {
+	if !w.buffering() {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.body.Len() > 0 || w.ResponseWriter.Written()
+}
+
+// etagMatches reports whether an If-None-Match header names etag, using the
+// weak comparison the header calls for.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import "testing"
+
+func TestETagMatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		etag        string
+		want        bool
+	}{
+		{"empty header", "", `"abc"`, false},
+		{"exact", `"abc"`, `"abc"`, true},
+		{"different", `"abc"`, `"def"`, false},
+		{"wildcard", "*", `"abc"`, true},
+		{"list", `"def", "abc"`, `"abc"`, true},
+		{"list without match", `"def", "ghi"`, `"abc"`, false},
+		{"weak header", `W/"abc"`, `"abc"`, true},
+		{"weak etag", `"abc"`, `W/"abc"`, true},
+		{"both weak", `W/"abc"`, `W/"abc"`, true},
+		{"weak list", ` W/"def" , W/"abc" `, `"abc"`, true},
+		{"unquoted", `abc`, `"abc"`, false},
+		{"prefix", `"ab"`, `"abc"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := etagMatches(tt.ifNoneMatch, tt.etag); got != tt.want {
+				t.Errorf("etagMatches(%q, %q) = %v, want %v", tt.ifNoneMatch, tt.etag, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/response"
+)
+
+// IPFilter refuses requests by client IP with 403. IPs in deny are always
+// refused; when allow is not empty, only IPs in it are let in. Entries are
+// IPs or CIDRs. The client IP honors the router's trusted proxies. Without
+// entries the middleware does nothing.
+func IPFilter(allow, deny []string) (gin.HandlerFunc, error) {
+	allowed, err := parseNetworks(allow)
+	if err != nil {
+		return nil, err
+	}
+	denied, err := parseNetworks(deny)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allowed) == 0 && len(denied) == 0 {
+		return func(c *gin.Context) { c.Next() }, nil
+	}
+
+	return func(c *gin.Context) {
+		ip := net.ParseIP(c.ClientIP())
+		if ip == nil || containsIP(denied, ip) || (len(allowed) > 0 && !containsIP(allowed, ip)) {
+			log.Printf("Refused %s %s from %s by IP filter", c.Request.Method, c.Request.URL.Path, c.ClientIP())
+			response.Error(c, http.StatusForbidden, response.CodeForbidden, "error.ip_not_allowed")
+			return
+		}
+		c.Next()
+	}, nil
+}
+
+// parseNetworks parses IPs and CIDRs; a single IP becomes a network of one
+// address.
+func parseNetworks(entries []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %w", entry, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// containsIP reports whether ip is in one of networks.
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
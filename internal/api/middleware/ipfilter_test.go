@@ -0,0 +1,78 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"net"
+	"testing"
+)
+
+func TestParseNetworks(t *testing.T) {
+	tests := []struct {
+		name     string
+		entries  []string
+		contains []string
+		excludes []string
+		wantErr  bool
+	}{
+		{name: "empty"},
+		{
+			name:     "IPv4 address",
+			entries:  []string{"192.0.2.1"},
+			contains: []string{"192.0.2.1"},
+			excludes: []string{"192.0.2.2", "::1"},
+		},
+		{
+			name:     "IPv6 address",
+			entries:  []string{"2001:db8::1"},
+			contains: []string{"2001:db8::1"},
+			excludes: []string{"2001:db8::2"},
+		},
+		{
+			name:     "IPv4 CIDR",
+			entries:  []string{"10.0.0.0/8"},
+			contains: []string{"10.0.0.1", "10.255.255.255"},
+			excludes: []string{"11.0.0.1"},
+		},
+		{
+			name:     "IPv6 CIDR",
+			entries:  []string{"2001:db8::/32"},
+			contains: []string{"2001:db8:1::1"},
+			excludes: []string{"2001:db9::1"},
+		},
+		{
+			name:     "mixed",
+			entries:  []string{"127.0.0.1", "192.168.0.0/16"},
+			contains: []string{"127.0.0.1", "192.168.1.1"},
+			excludes: []string{"127.0.0.2"},
+		},
+		{name: "invalid IP", entries: []string{"192.0.2.256"}, wantErr: true},
+		{name: "hostname", entries: []string{"localhost"}, wantErr: true},
+		{name: "invalid CIDR", entries: []string{"10.0.0.0/33"}, wantErr: true},
+		{name: "invalid entry after valid", entries: []string{"10.0.0.1", "nope/8"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networks, err := parseNetworks(tt.entries)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseNetworks(%q) error = %v, wantErr %v", tt.entries, err, tt.wantErr)
+			}
+			for _, ip := range tt.contains {
+				if !containsIP(networks, net.ParseIP(ip)) {
+					t.Errorf("networks from %q do not contain %s", tt.entries, ip)
+				}
+			}
+			for _, ip := range tt.excludes {
+				if containsIP(networks, net.ParseIP(ip)) {
+					t.Errorf("networks from %q contain %s", tt.entries, ip)
+				}
+			}
+		})
+	}
+}
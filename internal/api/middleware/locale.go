@@ -0,0 +1,35 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/i18n"
+)
+
+// LocaleKey is the Gin context key holding the negotiated locale.
+const LocaleKey = "locale"
+
+// Locale picks the request's locale from the Accept-Language header among
+// the locales bundle supports, falling back to the default locale. The
+// locale is stored in the Gin context and its localizer in the request
+// context, where response.Error uses it for error messages. The locale is
+// returned in the Content-Language response header.
+func Locale(bundle *i18n.Bundle) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		localizer := bundle.Localizer(bundle.Negotiate(c.GetHeader("Accept-Language")))
+
+		c.Set(LocaleKey, localizer.Locale())
+		c.Request = c.Request.WithContext(i18n.NewContext(c.Request.Context(), localizer))
+		c.Header("Content-Language", localizer.Locale())
+		c.Writer.Header().Add("Vary", "Accept-Language")
+
+		c.Next()
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Logger writes one access log line per request in Gin's default format,
+// followed by the request ID. It must run after RequestID.
+func Logger() gin.HandlerFunc {
+	return gin.LoggerWithFormatter(func(p gin.LogFormatterParams) string {
+		line := fmt.Sprintf("[GIN] %s | %3d | %13v | %15s | %-7s %#v | %s\n",
+			p.TimeStamp.Format("2006/01/02 - 15:04:05"),
+			p.StatusCode,
+			p.Latency.Truncate(time.Microsecond),
+			p.ClientIP,
+			p.Method,
+			p.Path,
+			p.Keys[RequestIDKey],
+		)
+		if p.ErrorMessage != "" {
+			line += p.ErrorMessage
+		}
+		return line
+	})
+}
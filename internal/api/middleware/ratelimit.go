@@ -0,0 +1,54 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/response"
+	"github.com/lusoris/venio/internal/ratelimit"
+)
+
+// RateLimit limits requests per client IP and reports the budget in
+// X-RateLimit-* headers. name separates the budgets of route groups. The
+// limit is looked up per request, so reloaded limits apply at once; a zero
+// rate disables it. When Redis cannot be reached requests are let through,
+// so an outage there does not take the API down with it.
+func RateLimit(limiter *ratelimit.Limiter, name string, getLimit func() ratelimit.Limit) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit := getLimit()
+		if limit.Rate <= 0 {
+			c.Next()
+			return
+		}
+
+		result, err := limiter.Allow(c.Request.Context(), name+":"+c.ClientIP(), limit)
+		if err != nil {
+			log.Printf("Rate limit check failed, allowing request: %v", err)
+			c.Next()
+			return
+		}
+
+		header := c.Writer.Header()
+		header.Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		header.Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(result.ResetAfter).Unix(), 10))
+
+		if !result.Allowed {
+			header.Set("Retry-After", strconv.FormatInt(int64((result.RetryAfter+time.Second-1)/time.Second), 10))
+			response.Error(c, http.StatusTooManyRequests, response.CodeRateLimited, "error.rate_limited")
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"runtime/debug"
+	"syscall"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/response"
+	"github.com/lusoris/venio/internal/errortracking"
+)
+
+// Recovery turns a panic in a handler into a 500 with the standard error
+// envelope, logs it with the request ID and stack trace, and reports it to
+// reporter. A panic caused by the client hanging up is logged without a
+// stack and not reported, since nothing can be sent anyway. It must run
+// after RequestID.
+func Recovery(reporter errortracking.Reporter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			// net/http aborts the connection silently for this one.
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			id := c.GetString(RequestIDKey)
+			if err, ok := recovered.(error); ok && clientGone(err) {
+				log.Printf("Client went away during %s %s (request %s): %v", c.Request.Method, c.Request.URL.Path, id, err)
+				c.Abort()
+				return
+			}
+
+			log.Printf("Panic in %s %s (request %s): %v\n%s", c.Request.Method, c.Request.URL.Path, id, recovered, debug.Stack())
+			stack := errortracking.Stack(1)
+			if c.Writer.Written() {
+				c.Abort()
+			} else {
+				response.Error(c, http.StatusInternalServerError, response.CodeInternalError, "error.internal")
+			}
+
+			err, ok := recovered.(error)
+			if !ok {
+				err = fmt.Errorf("panic: %v", recovered)
+			}
+			reportRequestError(c, reporter, errortracking.LevelFatal, err, stack)
+		}()
+
+		c.Next()
+	}
+}
+
+// clientGone reports whether err comes from writing to a connection the
+// client has closed.
+func clientGone(err error) bool {
+	return errors.Is(err, syscall.EPIPE) || errors.Is(err, syscall.ECONNRESET)
+}
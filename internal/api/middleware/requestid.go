@@ -0,0 +1,35 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/requestid"
+)
+
+// RequestIDKey is the Gin context key holding the request ID.
+const RequestIDKey = "request_id"
+
+// RequestID assigns every request an ID: the client's X-Request-ID when it
+// is usable, a random one otherwise. The ID is stored in the Gin and
+// request contexts and returned in the X-Request-ID response header.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		c.Set(RequestIDKey, id)
+		c.Request = c.Request.WithContext(requestid.NewContext(c.Request.Context(), id))
+		c.Header(requestid.Header, id)
+
+		c.Next()
+	}
+}
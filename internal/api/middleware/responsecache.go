@@ -0,0 +1,61 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/cache"
+)
+
+// CacheResponse serves successful GET responses of route from responses
+// for ttl, keyed by the query string, and reports HIT or MISS in X-Cache.
+// The cached response is the same for every caller, so the route must not
+// depend on who calls it beyond passing authentication. A zero ttl disables
+// caching.
+func CacheResponse(responses *cache.ResponseCache, route string, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if ttl <= 0 || c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		// Encode sorts the parameters, so their order does not matter.
+		sum := sha256.Sum256([]byte(c.Request.URL.Query().Encode()))
+		key := hex.EncodeToString(sum[:16])
+
+		if cached, ok := responses.Get(c.Request.Context(), route, key); ok {
+			c.Header("X-Cache", "HIT")
+			c.Data(cached.Status, cached.ContentType, cached.Body)
+			c.Abort()
+			return
+		}
+
+		w := &bufferedWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		if w.body.Len() == 0 {
+			return
+		}
+
+		responses.Set(c.Request.Context(), route, key, &cache.Response{
+			Status:      http.StatusOK,
+			ContentType: c.Writer.Header().Get("Content-Type"),
+			Body:        w.body.Bytes(),
+		}, ttl)
+		c.Header("X-Cache", "MISS")
+		_, _ = c.Writer.Write(w.body.Bytes())
+	}
+}
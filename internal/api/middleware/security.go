@@ -0,0 +1,64 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/config"
+)
+
+// SecurityHeaders adds the configured Content-Security-Policy,
+// X-Frame-Options, Referrer-Policy, and Strict-Transport-Security headers,
+// plus X-Content-Type-Options: nosniff, to every response. Routes that
+// serve content needing a different policy override it with
+// ContentSecurityPolicy.
+func SecurityHeaders(cfg config.HeadersConfig) gin.HandlerFunc {
+	hsts := ""
+	if cfg.HSTSMaxAge > 0 {
+		hsts = "max-age=" + strconv.FormatInt(int64(cfg.HSTSMaxAge.Seconds()), 10)
+		if cfg.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+		if cfg.HSTSPreload {
+			hsts += "; preload"
+		}
+	}
+
+	headers := map[string]string{
+		"Content-Security-Policy":   cfg.ContentSecurityPolicy,
+		"X-Frame-Options":           cfg.FrameOptions,
+		"Referrer-Policy":           cfg.ReferrerPolicy,
+		"Strict-Transport-Security": hsts,
+		"X-Content-Type-Options":    "nosniff",
+	}
+	for name, value := range headers {
+		if value == "" {
+			delete(headers, name)
+		}
+	}
+
+	return func(c *gin.Context) {
+		h := c.Writer.Header()
+		for name, value := range headers {
+			h.Set(name, value)
+		}
+		c.Next()
+	}
+}
+
+// ContentSecurityPolicy replaces the Content-Security-Policy set by
+// SecurityHeaders for a single route.
+func ContentSecurityPolicy(policy string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Content-Security-Policy", policy)
+		c.Next()
+	}
+}
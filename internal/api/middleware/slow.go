@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SlowRequests logs requests that take longer than threshold with their
+// route, status, actor, and request ID, so tail latency shows up in the
+// log without a metrics stack. Event streams and WebSocket connections
+// stay open by design and are not logged. A zero threshold disables it.
+func SlowRequests(threshold time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if threshold <= 0 {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		c.Next()
+		elapsed := time.Since(start)
+		if elapsed < threshold || streaming(c) {
+			return
+		}
+
+		actor := c.GetString(ActorKey)
+		if actor == "" {
+			actor = "anonymous"
+		}
+		log.Printf("Slow request: %s %s (route %s) -> %d by %s in %v (request %s)",
+			c.Request.Method, c.Request.URL.Path, c.FullPath(), c.Writer.Status(), actor,
+			elapsed.Truncate(time.Millisecond), c.GetString(RequestIDKey))
+	}
+}
+
+// streaming reports whether the request was a long-lived event stream or
+// WebSocket connection.
+func streaming(c *gin.Context) bool {
+	return strings.EqualFold(c.GetHeader("Upgrade"), "websocket") ||
+		strings.HasPrefix(c.Writer.Header().Get("Content-Type"), "text/event-stream")
+}
@@ -0,0 +1,41 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/response"
+)
+
+// Timeout cancels the request context after d, so database queries and
+// calls to other services give up. Handlers that fail because of it answer
+// 504 through response.Error; a handler that wrote nothing gets a 504 here.
+// A zero d disables the timeout. Streaming routes must not use it.
+func Timeout(d time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if d <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), d)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		if !c.Writer.Written() && ctx.Err() == context.DeadlineExceeded {
+			response.Error(c, http.StatusGatewayTimeout, response.CodeTimeout, "error.timeout")
+		}
+	}
+}
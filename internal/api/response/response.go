@@ -0,0 +1,88 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package response renders the API's standard JSON envelopes.
+package response
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/i18n"
+	"github.com/lusoris/venio/internal/requestid"
+)
+
+// Error codes returned in the error envelope.
+const (
+	CodeUnauthorized    = "UNAUTHORIZED"
+	CodeForbidden       = "FORBIDDEN"
+	CodeNotFound        = "NOT_FOUND"
+	CodeConflict        = "CONFLICT"
+	CodeValidationError = "VALIDATION_ERROR"
+	CodePayloadTooLarge = "PAYLOAD_TOO_LARGE"
+	CodeTimeout         = "TIMEOUT"
+	CodeRateLimited     = "RATE_LIMITED"
+	CodeInternalError   = "INTERNAL_ERROR"
+)
+
+// ErrorBody is the payload of the error envelope.
+type ErrorBody struct {
+	Code    string         `json:"code"`
+	Message string         `json:"message"`
+	Details map[string]any `json:"details,omitempty"`
+	// RequestID identifies the request in logs, for support requests.
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// ErrorEnvelope is the top-level JSON object returned for failed requests.
+type ErrorEnvelope struct {
+	Error ErrorBody `json:"error"`
+}
+
+// Error aborts the request and writes the standard error envelope. message
+// is a catalog key such as "error.instance_not_found", translated into the
+// request's locale with args as placeholders (see i18n.Localizer.T); text
+// that is not a key, such as a service error, is sent as is. Server errors
+// caused by the request running out of time are reported as 504.
+func Error(c *gin.Context, status int, code, message string, args ...any) {
+	if status >= http.StatusInternalServerError && errors.Is(c.Request.Context().Err(), context.DeadlineExceeded) {
+		status, code, message, args = http.StatusGatewayTimeout, CodeTimeout, "error.timeout", nil
+	}
+	abort(c, status, code, translate(c, message, args), nil)
+}
+
+// ValidationError aborts the request with a 400 whose details map each
+// invalid field to what is wrong with it, in the request's locale.
+func ValidationError(c *gin.Context, fields map[string]string) {
+	abort(c, http.StatusBadRequest, CodeValidationError, translate(c, "error.validation", nil),
+		map[string]any{"fields": fields})
+}
+
+// abort writes the error envelope.
+func abort(c *gin.Context, status int, code, message string, details map[string]any) {
+	c.AbortWithStatusJSON(status, ErrorEnvelope{
+		Error: ErrorBody{
+			Code:      code,
+			Message:   message,
+			Details:   details,
+			RequestID: requestid.FromContext(c.Request.Context()),
+		},
+	})
+}
+
+// translate returns the message for key in the request's locale, or key
+// itself when it is not a catalog key.
+func translate(c *gin.Context, key string, args []any) string {
+	l := i18n.FromContext(c.Request.Context())
+	if !l.Has(key) {
+		return key
+	}
+	return l.T(key, args...)
+}
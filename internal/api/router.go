@@ -0,0 +1,231 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package api wires HTTP routes, middleware, and handlers together.
+package api
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lusoris/venio/internal/api/handlers"
+	"github.com/lusoris/venio/internal/api/middleware"
+	"github.com/lusoris/venio/internal/audit"
+	"github.com/lusoris/venio/internal/cache"
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/errortracking"
+	"github.com/lusoris/venio/internal/i18n"
+	"github.com/lusoris/venio/internal/ratelimit"
+	"github.com/lusoris/venio/internal/services"
+	"github.com/lusoris/venio/internal/stream"
+)
+
+// emailPreviewCSP lets rendered emails use their inline styles and remote
+// logo while keeping scripts out.
+const emailPreviewCSP = "default-src 'none'; style-src 'unsafe-inline'; img-src https: data:; frame-ancestors 'self'"
+
+// Dependencies bundles everything the router needs to build its handlers.
+type Dependencies struct {
+	Config              *config.Config
+	Reloader            *config.Reloader
+	Bundle              *i18n.Bundle
+	AuditLogger         audit.Logger
+	ErrorReporter       errortracking.Reporter
+	MetadataCache       *cache.MetadataCache
+	ResponseCache       *cache.ResponseCache
+	InstanceService     *services.InstanceService
+	JobService          *services.JobService
+	WebhookService      *services.WebhookService
+	NotificationService *services.NotificationService
+	EmailService        *services.EmailService
+	EventBroker         *stream.Broker
+	RateLimiter         *ratelimit.Limiter
+}
+
+// NewRouter builds the Gin engine with all API routes registered.
+func NewRouter(deps Dependencies) (*gin.Engine, error) {
+	// Gin's debug output, such as the registered routes, is debug-level.
+	if deps.Config.Log.Level != config.LogLevelDebug {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	router := gin.New()
+	// Only trusted proxies may set the client IP (c.ClientIP).
+	if err := router.SetTrustedProxies(deps.Config.Server.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+	router.RemoteIPHeaders = []string{deps.Config.Server.RealIPHeader}
+
+	ipFilter, err := middleware.IPFilter(deps.Config.Security.IPAllowlist, deps.Config.Security.IPDenylist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IP filter: %w", err)
+	}
+	adminIPFilter, err := middleware.IPFilter(deps.Config.Security.AdminIPAllowlist, deps.Config.Security.AdminIPDenylist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin IP filter: %w", err)
+	}
+	// CORS origins, rate limits, and the API key apply to requests as soon
+	// as they are reloaded; GET /api/v1/admin/config shows the last loaded
+	// configuration.
+	loaded := config.NewValue(deps.Config)
+	cors := config.NewValue(deps.Config.CORS)
+	rateLimits := config.NewValue(deps.Config.RateLimit)
+	apiKey := config.NewValue(deps.Config.Security.APIKey)
+	deps.Reloader.Subscribe(func(cfg *config.Config) {
+		loaded.Store(cfg)
+		cors.Store(cfg.CORS)
+		rateLimits.Store(cfg.RateLimit)
+		apiKey.Store(cfg.Security.APIKey)
+	})
+
+	router.Use(
+		middleware.RequestID(),
+		middleware.Locale(deps.Bundle),
+		middleware.Logger(),
+		middleware.SlowRequests(deps.Config.Server.SlowRequestThreshold),
+	)
+	if deps.Config.BodyLog.Enabled() {
+		router.Use(middleware.BodyLog(middleware.BodyLogOptions{
+			SampleRate:   deps.Config.BodyLog.SampleRate,
+			Routes:       deps.Config.BodyLog.Routes,
+			MaxSize:      deps.Config.BodyLog.MaxSize,
+			RedactFields: deps.Config.Audit.RedactFields,
+		}))
+	}
+	if deps.Config.Audit.Enabled {
+		router.Use(middleware.Audit(deps.AuditLogger, middleware.AuditOptions{
+			LogBodies:    deps.Config.Audit.LogBodies,
+			RedactFields: deps.Config.Audit.RedactFields,
+		}))
+	}
+	router.Use(
+		middleware.Recovery(deps.ErrorReporter),
+		middleware.ReportErrors(deps.ErrorReporter),
+		middleware.SecurityHeaders(deps.Config.Headers),
+		ipFilter,
+		middleware.CORS(cors),
+		middleware.BodyLimit(deps.Config.Server.MaxBodySize),
+	)
+
+	cacheHandler := handlers.NewCacheHandler(deps.MetadataCache)
+	configHandler := handlers.NewConfigHandler(loaded)
+	instanceHandler := handlers.NewInstanceHandler(deps.InstanceService)
+	jobHandler := handlers.NewJobHandler(deps.JobService, deps.Config.Lists.PageSize("jobs"))
+	webhookHandler := handlers.NewWebhookHandler(deps.WebhookService, deps.Config.Lists.PageSize("deliveries"))
+	notificationHandler := handlers.NewNotificationHandler(deps.NotificationService)
+	emailHandler := handlers.NewEmailHandler(deps.EmailService, deps.Config.Email.FeedbackToken, deps.Config.Lists.PageSize("suppressions"))
+	eventHandler := handlers.NewEventHandler(deps.EventBroker)
+	webSocketHandler := handlers.NewWebSocketHandler(deps.EventBroker, apiKey)
+
+	v1 := router.Group("/api/v1")
+
+	// Jobs are started through admin endpoints, so polling them requires the
+	// same credentials.
+	apiTimeout := middleware.Timeout(deps.Config.Server.RequestTimeouts["api"])
+	apiRateLimit := groupRateLimit(deps, rateLimits, "api")
+
+	jobs := v1.Group("/jobs",
+		apiRateLimit,
+		apiTimeout,
+		middleware.RequireAPIKey(apiKey),
+		middleware.ETag(),
+	)
+	{
+		jobs.GET("/:id", jobHandler.GetStatus)
+	}
+
+	// The event stream carries admin data such as instance changes. Streams
+	// stay open, so they have no request timeout.
+	v1.GET("/events", apiRateLimit, middleware.RequireAPIKey(apiKey), eventHandler.Stream)
+	// WebSocket clients authenticate after connecting; see the handler.
+	v1.GET("/ws", apiRateLimit, webSocketHandler.Connect)
+	// Email providers authenticate with a token in the URL; see the handler.
+	// Their reports are automated, so they stay out of the audit log.
+	v1.POST("/email/feedback/:provider",
+		middleware.NoAudit(), groupRateLimit(deps, rateLimits, "webhooks"), apiTimeout, middleware.BodyLimit(deps.Config.Email.FeedbackMaxBodySize), emailHandler.Feedback)
+
+	admin := v1.Group("/admin",
+		adminIPFilter,
+		groupRateLimit(deps, rateLimits, "admin"),
+		middleware.Timeout(deps.Config.Server.RequestTimeouts["admin"]),
+		middleware.RequireAPIKey(apiKey),
+		middleware.ETag(),
+	)
+	{
+		admin.DELETE("/cache/metadata", cacheHandler.InvalidateMetadata)
+
+		admin.GET("/config", configHandler.Get)
+
+		admin.GET("/instances", cacheResponse(deps, cache.RouteInstances), instanceHandler.List)
+		admin.POST("/instances", instanceHandler.Create)
+		admin.POST("/instances/refresh-profiles", instanceHandler.RefreshProfiles)
+		admin.GET("/instances/:id", instanceHandler.Get)
+		admin.PUT("/instances/:id", instanceHandler.Update)
+		admin.DELETE("/instances/:id", instanceHandler.Delete)
+		admin.POST("/instances/:id/test", instanceHandler.TestConnection)
+
+		admin.GET("/jobs/queues", cacheResponse(deps, cache.RouteJobQueues), jobHandler.ListQueues)
+		admin.GET("/jobs/history", jobHandler.ListHistory)
+		admin.GET("/jobs/dead", jobHandler.ListDead)
+		admin.POST("/jobs/dead/:id/requeue", jobHandler.RequeueDead)
+		admin.DELETE("/jobs/dead/:id", jobHandler.DeleteDead)
+
+		admin.GET("/webhooks", webhookHandler.List)
+		admin.POST("/webhooks", webhookHandler.Create)
+		admin.GET("/webhooks/:id", webhookHandler.Get)
+		admin.PUT("/webhooks/:id", webhookHandler.Update)
+		admin.DELETE("/webhooks/:id", webhookHandler.Delete)
+		admin.GET("/webhooks/:id/deliveries", webhookHandler.Deliveries)
+		admin.POST("/webhooks/:id/deliveries/:deliveryId/redeliver", webhookHandler.Redeliver)
+
+		admin.GET("/notifications/agents", notificationHandler.List)
+		admin.POST("/notifications/agents", notificationHandler.Create)
+		admin.GET("/notifications/agents/:id", notificationHandler.Get)
+		admin.PUT("/notifications/agents/:id", notificationHandler.Update)
+		admin.DELETE("/notifications/agents/:id", notificationHandler.Delete)
+		admin.POST("/notifications/agents/:id/test", notificationHandler.Test)
+
+		admin.GET("/email/templates/:name/preview",
+			middleware.ContentSecurityPolicy(emailPreviewCSP), emailHandler.Preview)
+		admin.POST("/email/test", emailHandler.Test)
+		admin.GET("/email/suppressions", emailHandler.ListSuppressions)
+		admin.DELETE("/email/suppressions/:id", emailHandler.DeleteSuppression)
+	}
+
+	// Profiling needs the same credentials as the admin API. Profiles run
+	// for as long as requested, so there is no request timeout.
+	if deps.Config.Server.PprofEnabled {
+		registerPprof(router.Group("/debug/pprof",
+			adminIPFilter,
+			groupRateLimit(deps, rateLimits, "admin"),
+			middleware.RequireAPIKey(apiKey),
+		))
+	}
+
+	return router, nil
+}
+
+// cacheResponse returns the response cache middleware for a route, with
+// the TTL configured by CACHE_RESPONSE_TTLS.
+func cacheResponse(deps Dependencies, route string) gin.HandlerFunc {
+	return middleware.CacheResponse(deps.ResponseCache, route, deps.Config.Cache.ResponseTTLs[route])
+}
+
+// groupRateLimit returns the rate limit middleware for a route group,
+// configured by RATE_LIMIT_REQUESTS, RATE_LIMIT_WINDOWS, and
+// RATE_LIMIT_BURSTS.
+func groupRateLimit(deps Dependencies, limits *config.Value[config.RateLimitConfig], group string) gin.HandlerFunc {
+	return middleware.RateLimit(deps.RateLimiter, group, func() ratelimit.Limit {
+		requests, window, burst := limits.Load().Limit(group)
+		return ratelimit.Limit{
+			Rate:   requests,
+			Period: window,
+			Burst:  burst,
+		}
+	})
+}
@@ -0,0 +1,219 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package audit records who changed what, and when.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lusoris/venio/internal/requestid"
+)
+
+// ActionRequest is the action of entries recorded for API requests.
+// Services record named actions such as "instance.delete".
+const ActionRequest = "http.request"
+
+// Entry is one audited action.
+type Entry struct {
+	ID   int64     `json:"id,omitempty"`
+	Time time.Time `json:"time"`
+	// Action is ActionRequest or what a service did, e.g.
+	// "webhook.update". Resource and ResourceID name what it did it to.
+	Action     string `json:"action"`
+	Resource   string `json:"resource,omitempty"`
+	ResourceID string `json:"resource_id,omitempty"`
+	// Actor identifies who acted, e.g. "api_key" for a caller holding the
+	// admin API key, "anonymous", or "system" for background tasks.
+	Actor    string `json:"actor"`
+	ClientIP string `json:"client_ip,omitempty"`
+	Method   string `json:"method,omitempty"`
+	// Route is the route pattern, e.g. "/api/v1/admin/instances/:id", and
+	// Path the requested path. Status and Latency are zero for entries
+	// recorded by services.
+	Route     string        `json:"route,omitempty"`
+	Path      string        `json:"path,omitempty"`
+	Status    int           `json:"status,omitempty"`
+	Latency   time.Duration `json:"latency,omitempty"`
+	RequestID string        `json:"request_id,omitempty"`
+	// Body is the request body with secrets redacted, when bodies are
+	// recorded.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// NewEntry returns an entry for an action of a service on a resource,
+// taking the actor and request ID from ctx.
+func NewEntry(ctx context.Context, action, resource, resourceID string) *Entry {
+	return &Entry{
+		Time:       time.Now(),
+		Action:     action,
+		Resource:   resource,
+		ResourceID: resourceID,
+		Actor:      ActorFromContext(ctx),
+		RequestID:  requestid.FromContext(ctx),
+	}
+}
+
+// Logger records audit entries.
+type Logger interface {
+	Log(ctx context.Context, entry *Entry)
+}
+
+// Discard is a Logger that drops every entry, used when auditing is
+// disabled.
+var Discard Logger = discard{}
+
+type discard struct{}
+
+func (discard) Log(context.Context, *Entry) {}
+
+// Writer stores batches of audit entries.
+type Writer interface {
+	Write(ctx context.Context, entries []*Entry) error
+}
+
+// WriterFunc adapts a function to a Writer.
+type WriterFunc func(ctx context.Context, entries []*Entry) error
+
+// Write implements Writer.
+func (f WriterFunc) Write(ctx context.Context, entries []*Entry) error {
+	return f(ctx, entries)
+}
+
+// StdLogger writes audit entries to the standard logger, one line each.
+type StdLogger struct{}
+
+// Log implements Logger.
+func (StdLogger) Log(_ context.Context, e *Entry) {
+	if e.Action != ActionRequest {
+		log.Printf("Audit: %s %s/%s by %s (request %s)", e.Action, e.Resource, e.ResourceID, e.Actor, e.RequestID)
+		return
+	}
+
+	line := e.Method + " " + e.Path
+	if len(e.Body) > 0 {
+		line += " " + string(e.Body)
+	}
+	log.Printf("Audit: %s -> %d by %s from %s in %v (request %s)",
+		line, e.Status, e.Actor, e.ClientIP, e.Latency.Truncate(time.Microsecond), e.RequestID)
+}
+
+// Write implements Writer.
+func (l StdLogger) Write(ctx context.Context, entries []*Entry) error {
+	for _, e := range entries {
+		l.Log(ctx, e)
+	}
+	return nil
+}
+
+// Batching of AsyncLogger: entries are written once maxBatch of them are
+// waiting or flushInterval has passed, and a write may take writeTimeout.
+const (
+	maxBatch      = 100
+	flushInterval = time.Second
+	writeTimeout  = 10 * time.Second
+)
+
+// AsyncLogger hands entries to a Writer in batches in the background, so
+// recording them does not hold up requests. Entries that do not fit into
+// the buffer are dropped and logged, and so are batches the Writer fails
+// to store.
+type AsyncLogger struct {
+	next    Writer
+	entries chan *Entry
+	done    chan struct{}
+
+	// mu guards closed, so that Log never sends on the closed channel.
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewAsyncLogger starts passing entries to next, buffering up to size of
+// them.
+func NewAsyncLogger(next Writer, size int) *AsyncLogger {
+	a := &AsyncLogger{
+		next:    next,
+		entries: make(chan *Entry, size),
+		done:    make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+// Log implements Logger. It never blocks. Entries logged after Close, by
+// requests still running when the server gave up draining, go to the
+// standard logger.
+func (a *AsyncLogger) Log(ctx context.Context, e *Entry) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.closed {
+		StdLogger{}.Log(ctx, e)
+		return
+	}
+	select {
+	case a.entries <- e:
+	default:
+		log.Printf("Audit buffer full, dropped %s entry (request %s)", e.Action, e.RequestID)
+	}
+}
+
+// Close writes the buffered entries and stops. It may be called more than
+// once.
+func (a *AsyncLogger) Close() {
+	a.mu.Lock()
+	if !a.closed {
+		a.closed = true
+		close(a.entries)
+	}
+	a.mu.Unlock()
+	<-a.done
+}
+
+// run passes entries on until the logger is closed.
+func (a *AsyncLogger) run() {
+	defer close(a.done)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Entry, 0, maxBatch)
+	for {
+		select {
+		case e, ok := <-a.entries:
+			if !ok {
+				a.flush(batch)
+				return
+			}
+			batch = append(batch, e)
+			if len(batch) < maxBatch {
+				continue
+			}
+		case <-ticker.C:
+		}
+		a.flush(batch)
+		batch = batch[:0]
+	}
+}
+
+// flush writes a batch, falling back to the standard logger so that the
+// entries are not lost without a trace.
+func (a *AsyncLogger) flush(batch []*Entry) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+	if err := a.next.Write(ctx, batch); err != nil {
+		log.Printf("Failed to store %d audit entries, logging them instead: %v", len(batch), err)
+		_ = StdLogger{}.Write(ctx, batch)
+	}
+}
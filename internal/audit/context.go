@@ -0,0 +1,27 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package audit
+
+import "context"
+
+// actorKey is the context key of the actor.
+type actorKey struct{}
+
+// WithActor returns a copy of ctx naming the actor of the request.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor in ctx, or "system" when there is
+// none, as for background tasks.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok {
+		return actor
+	}
+	return "system"
+}
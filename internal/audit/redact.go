@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package audit
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// redacted replaces the values of redacted fields.
+const redacted = "[REDACTED]"
+
+// Redact returns a JSON body with the values of the named fields replaced,
+// at any depth. Field names are matched case-insensitively. A body that is
+// not JSON returns nil, since it cannot be redacted safely.
+func Redact(body []byte, fields []string) json.RawMessage {
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil
+	}
+
+	encoded, err := json.Marshal(redactValue(value, fields))
+	if err != nil {
+		return nil
+	}
+	return encoded
+}
+
+// redactValue walks value, replacing the values of fields in objects.
+func redactValue(value any, fields []string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for name, field := range v {
+			if slices.ContainsFunc(fields, func(f string) bool { return strings.EqualFold(f, name) }) {
+				v[name] = redacted
+			} else {
+				v[name] = redactValue(field, fields)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item, fields)
+		}
+	}
+	return value
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package cache provides a Redis-backed cache with stampede protection.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+// keyPrefix namespaces every key written by the cache.
+const keyPrefix = "venio:cache:"
+
+// scanBatchSize is the COUNT hint used when scanning keys for invalidation.
+const scanBatchSize = 500
+
+// loadTimeout bounds a shared load. It runs without the deadline of the
+// caller that started it, which may be cancelled while others still wait.
+const loadTimeout = time.Minute
+
+// Loader fetches a value from its source of truth on a cache miss.
+type Loader[T any] func(ctx context.Context) (T, error)
+
+// Cache is a JSON-encoding cache on top of Redis. Concurrent misses for the
+// same key are collapsed into a single load.
+type Cache struct {
+	client redis.UniversalClient
+	group  singleflight.Group
+}
+
+// New creates a cache backed by the given Redis client.
+func New(client redis.UniversalClient) *Cache {
+	return &Cache{client: client}
+}
+
+// GetOrLoad returns the cached value for key, calling load and storing its
+// result with the given TTL on a miss. Redis errors are treated as misses so
+// that an unavailable cache degrades to calling the loader directly.
+//
+// Concurrent misses share one load, which keeps the values of ctx but not
+// its cancellation and is bounded by loadTimeout instead. A caller whose
+// ctx ends stops waiting and gets its error; the others get the result.
+func GetOrLoad[T any](ctx context.Context, c *Cache, key string, ttl time.Duration, load Loader[T]) (T, error) {
+	fullKey := keyPrefix + key
+
+	var value T
+	raw, err := c.client.Get(ctx, fullKey).Bytes()
+	if err == nil {
+		if err = json.Unmarshal(raw, &value); err == nil {
+			return value, nil
+		}
+	}
+
+	results := c.group.DoChan(fullKey, func() (interface{}, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+		defer cancel()
+
+		loaded, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		if encoded, err := json.Marshal(loaded); err == nil {
+			// A failed write only costs us a future miss.
+			_ = c.client.Set(loadCtx, fullKey, encoded, ttl).Err()
+		}
+
+		return loaded, nil
+	})
+
+	select {
+	case result := <-results:
+		if result.Err != nil {
+			return value, result.Err
+		}
+		return result.Val.(T), nil
+	case <-ctx.Done():
+		return value, ctx.Err()
+	}
+}
+
+// Delete removes a single key from the cache.
+func (c *Cache) Delete(ctx context.Context, key string) error {
+	if err := c.client.Del(ctx, keyPrefix+key).Err(); err != nil {
+		return fmt.Errorf("failed to delete cache key: %w", err)
+	}
+	return nil
+}
+
+// DeletePrefix removes every key starting with prefix and returns how many
+// keys were deleted. An empty prefix clears the whole cache namespace.
+func (c *Cache) DeletePrefix(ctx context.Context, prefix string) (int64, error) {
+	var (
+		cursor  uint64
+		deleted int64
+	)
+
+	for {
+		keys, next, err := c.client.Scan(ctx, cursor, keyPrefix+prefix+"*", scanBatchSize).Result()
+		if err != nil {
+			return deleted, fmt.Errorf("failed to scan cache keys: %w", err)
+		}
+
+		if len(keys) > 0 {
+			n, err := c.client.Del(ctx, keys...).Result()
+			if err != nil && !errors.Is(err, redis.Nil) {
+				return deleted, fmt.Errorf("failed to delete cache keys: %w", err)
+			}
+			deleted += n
+		}
+
+		if next == 0 {
+			return deleted, nil
+		}
+		cursor = next
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// newUnreachableCache returns a cache whose Redis refuses connections, so
+// every lookup is a miss that calls the loader.
+func newUnreachableCache(t *testing.T) *Cache {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	client := redis.NewClient(&redis.Options{Addr: addr, MaxRetries: -1})
+	t.Cleanup(func() { client.Close() })
+	return New(client)
+}
+
+// missHook signals on missed when a lookup made with a context carrying
+// missKey has failed, i.e. right before GetOrLoad joins the shared load.
+type missHook struct {
+	missed chan struct{}
+	once   *sync.Once
+}
+
+type missKey struct{}
+
+func (h missHook) DialHook(next redis.DialHook) redis.DialHook { return next }
+
+func (h missHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		err := next(ctx, cmd)
+		if ctx.Value(missKey{}) != nil {
+			h.once.Do(func() { close(h.missed) })
+		}
+		return err
+	}
+}
+
+func (h missHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return next
+}
+
+func TestGetOrLoadCancelledCallerDoesNotFailOthers(t *testing.T) {
+	c := newUnreachableCache(t)
+	hook := missHook{missed: make(chan struct{}), once: new(sync.Once)}
+	c.client.AddHook(hook)
+
+	var calls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	loadErr := make(chan error, 2)
+	load := func(ctx context.Context) (string, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		loadErr <- ctx.Err()
+		return "value", ctx.Err()
+	}
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := GetOrLoad(firstCtx, c, "key", time.Minute, load)
+		firstErr <- err
+	}()
+	<-started
+
+	type result struct {
+		value string
+		err   error
+	}
+	second := make(chan result, 1)
+	go func() {
+		ctx := context.WithValue(context.Background(), missKey{}, true)
+		value, err := GetOrLoad(ctx, c, "key", time.Minute, load)
+		second <- result{value, err}
+	}()
+	// The second caller joins the load, which is still blocked, once its
+	// lookup has missed.
+	<-hook.missed
+
+	cancelFirst()
+	select {
+	case err := <-firstErr:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("cancelled caller error = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		close(release)
+		t.Fatal("cancelled caller kept waiting for the shared load")
+	}
+
+	close(release)
+	if err := <-loadErr; err != nil {
+		t.Errorf("shared load context error = %v, want none after the caller that started it was cancelled", err)
+	}
+	if r := <-second; r.err != nil || r.value != "value" {
+		t.Errorf("waiting caller = %q, %v; want the loaded value", r.value, r.err)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("loader ran %d times, want once", n)
+	}
+}
+
+func TestGetOrLoadError(t *testing.T) {
+	c := newUnreachableCache(t)
+	want := errors.New("load failed")
+
+	_, err := GetOrLoad(context.Background(), c, "key", time.Minute, func(context.Context) (int, error) {
+		return 0, want
+	})
+	if !errors.Is(err, want) {
+		t.Errorf("GetOrLoad() error = %v, want %v", err, want)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lusoris/venio/internal/models"
+)
+
+// Kind groups metadata cache entries so they can be invalidated together.
+type Kind string
+
+// Metadata cache kinds.
+const (
+	KindTMDB        Kind = "tmdb"
+	KindArrProfiles Kind = "arr_profiles"
+	KindPlexGUID    Kind = "plex_guid"
+)
+
+// Valid reports whether k is a known metadata kind.
+func (k Kind) Valid() bool {
+	switch k {
+	case KindTMDB, KindArrProfiles, KindPlexGUID:
+		return true
+	default:
+		return false
+	}
+}
+
+// metadataPrefix is the key prefix shared by all metadata entries.
+const metadataPrefix = "meta:"
+
+// TTLs configures how long each kind of metadata stays cached.
+type TTLs struct {
+	TMDB        time.Duration
+	ArrProfiles time.Duration
+	PlexGUID    time.Duration
+}
+
+// MetadataCache caches external metadata lookups with per-kind TTLs.
+type MetadataCache struct {
+	cache *Cache
+	ttls  TTLs
+}
+
+// NewMetadataCache creates a metadata cache on top of c.
+func NewMetadataCache(c *Cache, ttls TTLs) *MetadataCache {
+	return &MetadataCache{cache: c, ttls: ttls}
+}
+
+// TMDBDetails returns TMDB details for a movie or show, loading them on a miss.
+func (m *MetadataCache) TMDBDetails(ctx context.Context, mediaType models.MediaType, tmdbID int64, load Loader[*models.MediaDetails]) (*models.MediaDetails, error) {
+	key := fmt.Sprintf("%s%s:%s:%d", metadataPrefix, KindTMDB, mediaType, tmdbID)
+	return GetOrLoad(ctx, m.cache, key, m.ttls.TMDB, load)
+}
+
+// ArrProfiles returns the quality profiles of an Arr instance, loading them
+// on a miss.
+func (m *MetadataCache) ArrProfiles(ctx context.Context, instance string, load Loader[[]models.QualityProfile]) ([]models.QualityProfile, error) {
+	return GetOrLoad(ctx, m.cache, arrProfilesKey(instance), m.ttls.ArrProfiles, load)
+}
+
+// InvalidateArrProfiles removes the cached quality profiles of one instance.
+func (m *MetadataCache) InvalidateArrProfiles(ctx context.Context, instance string) error {
+	return m.cache.Delete(ctx, arrProfilesKey(instance))
+}
+
+// PlexGUID returns the external IDs for a Plex GUID, loading them on a miss.
+func (m *MetadataCache) PlexGUID(ctx context.Context, guid string, load Loader[*models.GUIDMapping]) (*models.GUIDMapping, error) {
+	key := fmt.Sprintf("%s%s:%s", metadataPrefix, KindPlexGUID, guid)
+	return GetOrLoad(ctx, m.cache, key, m.ttls.PlexGUID, load)
+}
+
+// arrProfilesKey returns the cache key of an instance's quality profiles.
+func arrProfilesKey(instance string) string {
+	return fmt.Sprintf("%s%s:%s", metadataPrefix, KindArrProfiles, instance)
+}
+
+// Invalidate removes all cached entries of the given kind, or every metadata
+// entry when kind is empty, and returns the number of keys removed.
+func (m *MetadataCache) Invalidate(ctx context.Context, kind Kind) (int64, error) {
+	prefix := metadataPrefix
+	if kind != "" {
+		prefix += string(kind) + ":"
+	}
+	return m.cache.DeletePrefix(ctx, prefix)
+}
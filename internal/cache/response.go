@@ -0,0 +1,79 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Routes whose responses are cached. Services changing the data behind a
+// route invalidate it by name.
+const (
+	RouteInstances = "instances"
+	RouteJobQueues = "job_queues"
+)
+
+// responsePrefix is the key prefix shared by all cached responses.
+const responsePrefix = "response:"
+
+// Response is a rendered HTTP response.
+type Response struct {
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// ResponseCache keeps rendered responses of expensive read endpoints.
+// Entries are grouped by route, so all variants of a route (e.g. different
+// query strings) can be invalidated together.
+type ResponseCache struct {
+	cache *Cache
+}
+
+// NewResponseCache creates a response cache on top of c.
+func NewResponseCache(c *Cache) *ResponseCache {
+	return &ResponseCache{cache: c}
+}
+
+// Get returns the cached response stored under key for route. Redis errors
+// are treated as misses.
+func (r *ResponseCache) Get(ctx context.Context, route, key string) (*Response, bool) {
+	raw, err := r.cache.client.Get(ctx, responseKey(route, key)).Bytes()
+	if err != nil {
+		return nil, false
+	}
+
+	var resp Response
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, false
+	}
+	return &resp, true
+}
+
+// Set stores resp under key for route with the given TTL.
+func (r *ResponseCache) Set(ctx context.Context, route, key string, resp *Response, ttl time.Duration) {
+	encoded, err := json.Marshal(resp)
+	if err != nil {
+		return
+	}
+	// A failed write only costs us a future miss.
+	_ = r.cache.client.Set(ctx, responseKey(route, key), encoded, ttl).Err()
+}
+
+// Invalidate removes every cached response of route.
+func (r *ResponseCache) Invalidate(ctx context.Context, route string) error {
+	_, err := r.cache.DeletePrefix(ctx, responsePrefix+route+":")
+	return err
+}
+
+// responseKey returns the cache key of a response.
+func responseKey(route, key string) string {
+	return keyPrefix + responsePrefix + route + ":" + key
+}
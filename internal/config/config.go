@@ -0,0 +1,1399 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package config loads Venio's runtime configuration from the environment.
+package config
+
+import (
+	"cmp"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/robfig/cron/v3"
+
+	"github.com/lusoris/venio/internal/encryption"
+	"github.com/lusoris/venio/internal/errortracking"
+)
+
+// Config holds the complete application configuration.
+type Config struct {
+	Server        ServerConfig
+	TLS           TLSConfig
+	CORS          CORSConfig
+	Headers       HeadersConfig
+	RateLimit     RateLimitConfig
+	Database      DatabaseConfig
+	Redis         RedisConfig
+	Cache         CacheConfig
+	Worker        WorkerConfig
+	Scheduler     SchedulerConfig
+	Outbox        OutboxConfig
+	Webhook       WebhookConfig
+	Notification  NotificationConfig
+	Email         EmailConfig
+	I18n          I18nConfig
+	Security      SecurityConfig
+	Audit         AuditConfig
+	ErrorTracking ErrorTrackingConfig
+	Log           LogConfig
+	BodyLog       BodyLogConfig
+	Startup       StartupConfig
+	Lists         ListConfig
+
+	// values holds the effective value of every variable read; see
+	// Redacted.
+	values map[string]string
+}
+
+// ServerConfig holds HTTP server settings.
+type ServerConfig struct {
+	Port int
+	Env  string
+	// MaxBodySize caps request bodies, in bytes.
+	MaxBodySize int64
+	// RequestTimeouts maps route groups ("api", "admin") to the time a
+	// request may take; zero disables the timeout. Event streams are exempt.
+	RequestTimeouts map[string]time.Duration
+	// TrustedProxies lists the IPs and CIDRs of reverse proxies whose
+	// RealIPHeader is believed. Empty trusts no proxy, so the client IP is
+	// the address of the connection.
+	TrustedProxies []string
+	// RealIPHeader is the header carrying the client IP set by a trusted
+	// proxy: X-Forwarded-For, X-Real-IP, or CF-Connecting-IP.
+	RealIPHeader string
+	// SlowRequestThreshold is the duration above which requests are
+	// logged as slow; zero disables the log. Event streams are exempt.
+	SlowRequestThreshold time.Duration
+	// ShutdownTimeout is how long in-flight requests may run after
+	// SIGTERM before they are aborted.
+	ShutdownTimeout time.Duration
+	// Connection limits of the HTTP server. ReadTimeout covers headers
+	// and body, WriteTimeout the response; zero disables a timeout.
+	// Event streams lift the write timeout.
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	MaxHeaderBytes    int
+	// PprofEnabled serves net/http/pprof under /debug/pprof to admin API
+	// key holders.
+	PprofEnabled bool
+	// SocketPath is a Unix domain socket to listen on instead of Port,
+	// created with SocketMode, an octal permission string such as "0660".
+	// A socket passed by systemd takes precedence over both.
+	SocketPath string
+	SocketMode string
+	// HTTP2 serves HTTP/2 to clients that negotiate it over TLS. H2C
+	// serves HTTP/2 without TLS, for reverse proxies that speak it to
+	// the backend.
+	HTTP2 bool
+	H2C   bool
+	// GracefulRestart makes SIGHUP start the binary anew and hand it the
+	// listener, so it can be upgraded without dropping connections.
+	GracefulRestart bool
+}
+
+// realIPHeaders lists the accepted values of ServerConfig.RealIPHeader.
+var realIPHeaders = []string{"X-Forwarded-For", "X-Real-IP", "CF-Connecting-IP"}
+
+// requestTimeoutGroups lists the route groups of ServerConfig.RequestTimeouts.
+var requestTimeoutGroups = []string{"api", "admin"}
+
+// validate checks the port and the proxy settings, which Gin would otherwise reject
+// only when the router is built, the request timeouts, and that responses
+// to requests that time out are not cut off by the write timeout first.
+func (s ServerConfig) validate() error {
+	var errs []error
+	if s.SocketPath == "" && (s.Port < 1 || s.Port > 65535) {
+		errs = append(errs, fmt.Errorf("invalid PORT %d: want 1 to 65535", s.Port))
+	}
+	errs = append(errs, validateNetworks("TRUSTED_PROXIES", s.TrustedProxies))
+	for _, group := range slices.Sorted(maps.Keys(s.RequestTimeouts)) {
+		timeout := s.RequestTimeouts[group]
+		if !slices.Contains(requestTimeoutGroups, group) {
+			errs = append(errs, fmt.Errorf("invalid REQUEST_TIMEOUTS group %q: want one of %s", group, strings.Join(requestTimeoutGroups, ", ")))
+		}
+		if timeout < 0 {
+			errs = append(errs, fmt.Errorf("invalid REQUEST_TIMEOUTS %s timeout %v: want a positive duration, or 0 to disable it", group, timeout))
+		}
+		if s.WriteTimeout > 0 && timeout >= s.WriteTimeout {
+			errs = append(errs, fmt.Errorf("SERVER_WRITE_TIMEOUT (%v) must exceed the %s request timeout (%v)", s.WriteTimeout, group, timeout))
+		}
+	}
+	if !slices.ContainsFunc(realIPHeaders, func(h string) bool { return strings.EqualFold(h, s.RealIPHeader) }) {
+		errs = append(errs, fmt.Errorf("invalid REAL_IP_HEADER %q: want one of %s", s.RealIPHeader, strings.Join(realIPHeaders, ", ")))
+	}
+	if mode, err := strconv.ParseUint(s.SocketMode, 8, 32); err != nil || mode > 0o777 {
+		errs = append(errs, fmt.Errorf("invalid SERVER_SOCKET_MODE %q: want octal permissions such as 0660", s.SocketMode))
+	}
+	return errors.Join(errs...)
+}
+
+// Addr returns the listen address for the HTTP server.
+func (s ServerConfig) Addr() string {
+	return fmt.Sprintf(":%d", s.Port)
+}
+
+// SocketFileMode returns SocketMode as file permissions.
+func (s ServerConfig) SocketFileMode() os.FileMode {
+	mode, _ := strconv.ParseUint(s.SocketMode, 8, 32)
+	return os.FileMode(mode)
+}
+
+// IsProduction reports whether the server runs in production mode.
+func (s ServerConfig) IsProduction() bool {
+	return s.Env == "production"
+}
+
+// CORSConfig controls which browser origins may call the API.
+type CORSConfig struct {
+	// AllowedOrigins lists origins such as "https://venio.example.com".
+	// "https://*.example.com" allows every subdomain and "*" any origin.
+	// Empty disables CORS, so browsers only allow same-origin requests.
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	AllowCredentials bool
+	// MaxAge is how long browsers may cache a preflight response.
+	MaxAge time.Duration
+}
+
+// validate checks the allowed origins, so a typo fails at startup instead
+// of silently blocking the frontend.
+func (c CORSConfig) validate() error {
+	var errs []error
+	for _, origin := range c.AllowedOrigins {
+		if origin == "*" {
+			if c.AllowCredentials {
+				errs = append(errs, errors.New("CORS_ALLOWED_ORIGINS cannot be * when CORS_ALLOW_CREDENTIALS is true"))
+			}
+			continue
+		}
+
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" ||
+			(u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.User != nil {
+			errs = append(errs, fmt.Errorf("invalid CORS origin %q: want scheme://host[:port]", origin))
+			continue
+		}
+		if host := strings.TrimPrefix(u.Host, "*."); strings.Contains(host, "*") {
+			errs = append(errs, fmt.Errorf("invalid CORS origin %q: * is only allowed as the first label", origin))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// TLSConfig enables HTTPS, either with a certificate from files, which
+// are reloaded when they change, or with certificates obtained through
+// ACME for ACMEDomains.
+type TLSConfig struct {
+	CertFile string
+	KeyFile  string
+
+	// ACMEDomains are the host names certificates are requested for; the
+	// server must be reachable on port 443 under them.
+	ACMEDomains  []string
+	ACMEEmail    string
+	ACMECacheDir string
+
+	// MinVersion is "1.2" or "1.3".
+	MinVersion string
+	// CipherSuites restricts the TLS 1.2 cipher suites, by Go name, e.g.
+	// "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256". Empty uses Go's defaults.
+	// TLS 1.3 suites are not configurable.
+	CipherSuites []string
+}
+
+// tlsVersions maps TLSConfig.MinVersion values to crypto/tls versions.
+var tlsVersions = map[string]uint16{"1.2": tls.VersionTLS12, "1.3": tls.VersionTLS13}
+
+// Enabled reports whether the server serves HTTPS.
+func (t TLSConfig) Enabled() bool {
+	return t.CertFile != "" || len(t.ACMEDomains) > 0
+}
+
+// MinTLSVersion returns MinVersion as a crypto/tls version.
+func (t TLSConfig) MinTLSVersion() uint16 {
+	return tlsVersions[t.MinVersion]
+}
+
+// CipherSuiteIDs returns CipherSuites as crypto/tls IDs, or nil for Go's
+// defaults. Unknown and insecure suites are skipped; validate rejects them.
+func (t TLSConfig) CipherSuiteIDs() []uint16 {
+	var ids []uint16
+	for _, suite := range tls.CipherSuites() {
+		if slices.Contains(t.CipherSuites, suite.Name) {
+			ids = append(ids, suite.ID)
+		}
+	}
+	return ids
+}
+
+// validate checks that exactly one certificate source is configured and
+// that the version and cipher suites are known.
+func (t TLSConfig) validate() error {
+	var errs []error
+	if (t.CertFile == "") != (t.KeyFile == "") {
+		errs = append(errs, errors.New("TLS_CERT_FILE and TLS_KEY_FILE must be set together"))
+	}
+	if t.CertFile != "" && len(t.ACMEDomains) > 0 {
+		errs = append(errs, errors.New("set either TLS_CERT_FILE or TLS_ACME_DOMAINS, not both"))
+	}
+	if len(t.ACMEDomains) > 0 && t.ACMECacheDir == "" {
+		errs = append(errs, errors.New("TLS_ACME_CACHE_DIR is required with TLS_ACME_DOMAINS"))
+	}
+	if _, ok := tlsVersions[t.MinVersion]; !ok {
+		errs = append(errs, fmt.Errorf("invalid TLS_MIN_VERSION %q: want 1.2 or 1.3", t.MinVersion))
+	}
+	if known := len(t.CipherSuiteIDs()); known != len(t.CipherSuites) {
+		errs = append(errs, fmt.Errorf("TLS_CIPHER_SUITES contains unknown or insecure suites: %s", strings.Join(t.CipherSuites, ", ")))
+	}
+	return errors.Join(errs...)
+}
+
+// HeadersConfig holds the security headers sent with every response. The
+// defaults are strict in production and relaxed elsewhere; an empty value
+// sends no header.
+type HeadersConfig struct {
+	ContentSecurityPolicy string
+	// FrameOptions is the X-Frame-Options value: DENY or SAMEORIGIN.
+	FrameOptions   string
+	ReferrerPolicy string
+	// HSTSMaxAge enables Strict-Transport-Security; zero disables it.
+	// Browsers ignore the header on plain HTTP.
+	HSTSMaxAge            time.Duration
+	HSTSIncludeSubdomains bool
+	HSTSPreload           bool
+}
+
+// Default security headers.
+const (
+	strictCSP  = "default-src 'none'; frame-ancestors 'none'; base-uri 'none'; form-action 'none'"
+	relaxedCSP = "default-src 'self'; img-src 'self' data: https:; style-src 'self' 'unsafe-inline'; frame-ancestors 'self'"
+	// hstsPreloadMinAge is the shortest max-age the HSTS preload list
+	// accepts.
+	hstsPreloadMinAge = 365 * 24 * time.Hour
+)
+
+// validate checks the frame options and that HSTS preloading meets the
+// preload list's requirements, since browsers ignore invalid values.
+func (h HeadersConfig) validate() error {
+	var errs []error
+	switch h.FrameOptions {
+	case "", "DENY", "SAMEORIGIN":
+	default:
+		errs = append(errs, fmt.Errorf("invalid FRAME_OPTIONS %q: want DENY, SAMEORIGIN, or empty", h.FrameOptions))
+	}
+	if h.HSTSPreload && (h.HSTSMaxAge < hstsPreloadMinAge || !h.HSTSIncludeSubdomains) {
+		errs = append(errs, errors.New("HSTS_PRELOAD needs HSTS_MAX_AGE of at least 8760h and HSTS_INCLUDE_SUBDOMAINS=true"))
+	}
+	return errors.Join(errs...)
+}
+
+// RateLimitGroups lists the route groups with their own rate limit:
+// "api" for jobs and event streams, "admin" for /api/v1/admin, and
+// "webhooks" for callbacks from external services.
+var RateLimitGroups = []string{"api", "admin", "webhooks"}
+
+// RateLimitConfig limits how many requests a client IP may make to each
+// route group. The maps are keyed by group.
+type RateLimitConfig struct {
+	// Requests is the number allowed per window; zero or missing disables
+	// the limit.
+	Requests map[string]int
+	Windows  map[string]time.Duration
+	// Bursts is how many requests may be made at once after a quiet spell.
+	// Zero or missing allows the whole window's requests at once.
+	Bursts map[string]int
+}
+
+// Limit returns the requests per window and the burst of a route group.
+func (r RateLimitConfig) Limit(group string) (requests int, window time.Duration, burst int) {
+	requests, window, burst = r.Requests[group], r.Windows[group], r.Bursts[group]
+	if burst <= 0 {
+		burst = requests
+	}
+	return requests, window, burst
+}
+
+// validate checks that the maps only name known groups, so a typo does not
+// silently leave a group unlimited, and that enabled limits have a window.
+func (r RateLimitConfig) validate() error {
+	var errs []error
+	lists := []struct {
+		name   string
+		groups []string
+	}{
+		{"RATE_LIMIT_REQUESTS", slices.Sorted(maps.Keys(r.Requests))},
+		{"RATE_LIMIT_WINDOWS", slices.Sorted(maps.Keys(r.Windows))},
+		{"RATE_LIMIT_BURSTS", slices.Sorted(maps.Keys(r.Bursts))},
+	}
+	for _, list := range lists {
+		for _, group := range list.groups {
+			if !slices.Contains(RateLimitGroups, group) {
+				errs = append(errs, fmt.Errorf("invalid %s group %q: want one of %s", list.name, group, strings.Join(RateLimitGroups, ", ")))
+			}
+		}
+	}
+
+	for _, group := range slices.Sorted(maps.Keys(r.Requests)) {
+		if r.Requests[group] > 0 && r.Windows[group] <= 0 {
+			errs = append(errs, fmt.Errorf("RATE_LIMIT_WINDOWS must set a positive window for %q", group))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DatabaseConfig holds PostgreSQL connection settings.
+type DatabaseConfig struct {
+	URL string
+	// ReplicaURL optionally points at a read replica for listings.
+	ReplicaURL string
+	// StatementTimeout cancels statements running longer, on the server
+	// and, should it not respond, on the client; zero disables it.
+	StatementTimeout time.Duration
+	// SlowQueryThreshold is the duration above which queries are logged
+	// as slow; zero disables the log.
+	SlowQueryThreshold time.Duration
+	// AutoMigrate applies pending migrations at startup.
+	AutoMigrate bool
+}
+
+// validate checks that URL and ReplicaURL are PostgreSQL URLs and that the
+// statement timeout is not negative.
+func (d DatabaseConfig) validate() error {
+	errs := []error{
+		validateDatabaseURL("DATABASE_URL", d.URL),
+		validateDatabaseURL("DATABASE_REPLICA_URL", d.ReplicaURL),
+	}
+	if d.StatementTimeout < 0 {
+		errs = append(errs, fmt.Errorf("invalid DATABASE_STATEMENT_TIMEOUT %v: want 0 or more", d.StatementTimeout))
+	}
+	return errors.Join(errs...)
+}
+
+// validateDatabaseURL checks that value of key is a PostgreSQL URL.
+// Connection strings of key=value pairs are left to pgx.
+func validateDatabaseURL(key, value string) error {
+	if !strings.Contains(value, "://") {
+		return nil
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		// The error would repeat the URL and its password.
+		return fmt.Errorf("invalid %s: malformed URL", key)
+	}
+	if u.Scheme != "postgres" && u.Scheme != "postgresql" {
+		return fmt.Errorf("invalid %s scheme %q: want postgres", key, u.Scheme)
+	}
+	return nil
+}
+
+// RedisConfig holds Redis connection settings.
+type RedisConfig struct {
+	Host     string
+	Port     int
+	Password string
+	DB       int
+}
+
+// Addr returns the host:port address of the Redis server.
+func (r RedisConfig) Addr() string {
+	return fmt.Sprintf("%s:%d", r.Host, r.Port)
+}
+
+// validate checks the port.
+func (r RedisConfig) validate() error {
+	if r.Port < 1 || r.Port > 65535 {
+		return fmt.Errorf("invalid REDIS_PORT %d: want 1 to 65535", r.Port)
+	}
+	return nil
+}
+
+// CacheConfig holds TTLs for the metadata and response caches.
+type CacheConfig struct {
+	TMDBTTL        time.Duration
+	ArrProfilesTTL time.Duration
+	PlexGUIDTTL    time.Duration
+	// ResponseTTLs maps cached routes ("instances", "job_queues") to how
+	// long their responses are served from the cache; zero disables it.
+	ResponseTTLs map[string]time.Duration
+}
+
+// Worker modes.
+const (
+	WorkerModeQueue  = "queue"
+	WorkerModeInline = "inline"
+)
+
+// WorkerConfig holds background worker settings.
+type WorkerConfig struct {
+	// Mode is WorkerModeQueue to hand tasks to cmd/worker through Redis, or
+	// WorkerModeInline to run them inside the API server process.
+	Mode string
+
+	// QueueConcurrency is the number of workers dedicated to each queue.
+	QueueConcurrency map[string]int
+
+	// ShutdownTimeout is how long in-flight tasks may run after SIGTERM
+	// before they are aborted and requeued.
+	ShutdownTimeout time.Duration
+
+	// JobStatusTTL is how long job status entries are kept after their
+	// last update.
+	JobStatusTTL time.Duration
+
+	// JobHistoryRetention is how long finished task runs are kept in the
+	// job history.
+	JobHistoryRetention time.Duration
+
+	// Default retry policy for tasks without a task-specific policy.
+	RetryMax       int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+
+	// ArrRequestTimeout bounds a single request to an Arr instance.
+	ArrRequestTimeout time.Duration
+}
+
+// validate checks the mode.
+func (w WorkerConfig) validate() error {
+	switch w.Mode {
+	case WorkerModeQueue, WorkerModeInline:
+	default:
+		return fmt.Errorf("invalid WORKER_MODE %q: want %s or %s", w.Mode, WorkerModeQueue, WorkerModeInline)
+	}
+	return nil
+}
+
+// SchedulerConfig holds cron specs for periodic tasks. An empty spec
+// disables the task. Specs accept cron syntax and "@every <duration>".
+type SchedulerConfig struct {
+	Timezone           string
+	ArrProfilesRefresh string
+	WebhookPrune       string
+	JobHistoryPrune    string
+	AuditPrune         string
+}
+
+// validate checks the timezone and parses each spec the way the
+// scheduler does, which would otherwise only fail when cmd/worker starts.
+func (s SchedulerConfig) validate() error {
+	var errs []error
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		errs = append(errs, fmt.Errorf("invalid SCHEDULER_TIMEZONE %q: %v", s.Timezone, err))
+	}
+	specs := []struct {
+		name string
+		spec string
+	}{
+		{"SCHEDULE_ARR_PROFILES_REFRESH", s.ArrProfilesRefresh},
+		{"SCHEDULE_WEBHOOK_PRUNE", s.WebhookPrune},
+		{"SCHEDULE_JOB_HISTORY_PRUNE", s.JobHistoryPrune},
+		{"SCHEDULE_AUDIT_PRUNE", s.AuditPrune},
+	}
+	for _, spec := range specs {
+		if spec.spec == "" {
+			continue
+		}
+		if _, err := cron.ParseStandard(spec.spec); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s %q: %v", spec.name, spec.spec, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// OutboxConfig holds settings for the transactional outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	// An event that failed is retried after RetryBaseDelay, doubled with
+	// every further failure up to RetryMaxDelay.
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	Retention      time.Duration
+}
+
+// validate checks that every setting is positive, as a zero poll interval
+// or batch size would stop or spin the relay, and that the retry delays
+// are in order.
+func (o OutboxConfig) validate() error {
+	var errs []error
+	if o.PollInterval <= 0 {
+		errs = append(errs, fmt.Errorf("invalid OUTBOX_POLL_INTERVAL %v: want a positive duration", o.PollInterval))
+	}
+	if o.BatchSize <= 0 {
+		errs = append(errs, fmt.Errorf("invalid OUTBOX_BATCH_SIZE %d: want a positive number", o.BatchSize))
+	}
+	if o.MaxAttempts <= 0 {
+		errs = append(errs, fmt.Errorf("invalid OUTBOX_MAX_ATTEMPTS %d: want a positive number", o.MaxAttempts))
+	}
+	if o.RetryBaseDelay <= 0 {
+		errs = append(errs, fmt.Errorf("invalid OUTBOX_RETRY_BASE_DELAY %v: want a positive duration", o.RetryBaseDelay))
+	}
+	if o.RetryMaxDelay < o.RetryBaseDelay {
+		errs = append(errs, fmt.Errorf("invalid OUTBOX_RETRY_MAX_DELAY %v: want at least OUTBOX_RETRY_BASE_DELAY (%v)", o.RetryMaxDelay, o.RetryBaseDelay))
+	}
+	if o.Retention <= 0 {
+		errs = append(errs, fmt.Errorf("invalid OUTBOX_RETENTION %v: want a positive duration", o.Retention))
+	}
+	return errors.Join(errs...)
+}
+
+// WebhookConfig holds settings for outgoing webhook delivery.
+type WebhookConfig struct {
+	// Timeout bounds a single delivery request.
+	Timeout time.Duration
+	// MaxFailures is the number of consecutive failed attempts after which
+	// a webhook is disabled.
+	MaxFailures int
+	// DeliveryRetention is how long delivery attempts are kept.
+	DeliveryRetention time.Duration
+}
+
+// NotificationConfig holds settings for notification agents.
+type NotificationConfig struct {
+	// Throttle maps event types to the window in which an agent is
+	// notified at most once about the same subject. "*" sets the default;
+	// a zero window disables throttling.
+	Throttle map[string]time.Duration
+}
+
+// EmailConfig holds outgoing email settings.
+type EmailConfig struct {
+	// Provider is "log", "smtp", "sendgrid", "mailgun", or "ses".
+	Provider string
+	From     string
+	FromName string
+
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	// SMTPTLS is "starttls", "tls" (implicit TLS), or "none".
+	SMTPTLS string
+
+	SendGridAPIKey string
+
+	MailgunDomain  string
+	MailgunAPIKey  string
+	MailgunBaseURL string
+
+	SESRegion          string
+	SESAccessKeyID     string
+	SESSecretAccessKey string
+
+	// Branding applied to every email.
+	BrandName            string
+	BrandLogoURL         string
+	BrandPrimaryColor    string
+	BrandBackgroundColor string
+
+	// TemplateDir optionally holds template files that replace the
+	// built-in ones with the same name.
+	TemplateDir string
+
+	// FeedbackToken authenticates bounce and complaint webhooks from the
+	// provider; empty disables them.
+	FeedbackToken string
+	// FeedbackMaxBodySize caps feedback requests, in bytes. Providers batch
+	// events, so it is larger than the API limit.
+	FeedbackMaxBodySize int64
+	// APITimeout bounds a single request to an HTTP email API.
+	APITimeout time.Duration
+}
+
+// emailProviders lists the accepted values of EmailConfig.Provider.
+var emailProviders = []string{"log", "smtp", "sendgrid", "mailgun", "ses"}
+
+// validate checks the provider.
+func (e EmailConfig) validate() error {
+	if !slices.Contains(emailProviders, e.Provider) {
+		return fmt.Errorf("invalid EMAIL_PROVIDER %q: want one of %s", e.Provider, strings.Join(emailProviders, ", "))
+	}
+	return nil
+}
+
+// I18nConfig holds localization settings.
+type I18nConfig struct {
+	// DefaultLocale is used for recipients without a supported locale.
+	DefaultLocale string
+}
+
+// SecurityConfig holds secrets used to protect the API and stored data.
+type SecurityConfig struct {
+	APIKey        string
+	EncryptionKey string
+
+	// IPAllowlist and IPDenylist hold IPs and CIDRs filtering every
+	// request by client IP; the Admin lists apply to /api/v1/admin on top
+	// of them. A denied IP is always refused; when an allowlist is set,
+	// only IPs on it are let in.
+	IPAllowlist      []string
+	IPDenylist       []string
+	AdminIPAllowlist []string
+	AdminIPDenylist  []string
+}
+
+// Audit log storage backends.
+const (
+	AuditStorageDatabase = "database"
+	AuditStorageLog      = "log"
+)
+
+// AuditConfig controls the audit log of changes made through the API.
+type AuditConfig struct {
+	Enabled bool
+	// Storage is AuditStorageDatabase to keep entries in the audit_log
+	// table or AuditStorageLog to only write them to the server log.
+	Storage string
+	// Retention is how long entries are kept in the database.
+	Retention time.Duration
+	// LogBodies records request bodies, with the values of RedactFields
+	// replaced.
+	LogBodies    bool
+	RedactFields []string
+	// BufferSize is how many entries may wait to be written before new
+	// ones are dropped.
+	BufferSize int
+}
+
+// ErrorTrackingConfig controls reporting of panics, server errors, and
+// failed tasks to Sentry or a compatible service such as GlitchTip.
+type ErrorTrackingConfig struct {
+	// DSN is the client key of the project; empty disables reporting.
+	DSN string
+	// Environment tags the events; it defaults to ENV.
+	Environment string
+}
+
+// validate checks the DSN.
+func (e ErrorTrackingConfig) validate() error {
+	if e.DSN == "" {
+		return nil
+	}
+	if _, err := errortracking.ParseDSN(e.DSN); err != nil {
+		return fmt.Errorf("invalid ERROR_TRACKING_DSN: %w", err)
+	}
+	return nil
+}
+
+// Log levels of LogConfig.Level.
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+// logLevels lists the accepted values of LogConfig.Level.
+var logLevels = []string{LogLevelDebug, LogLevelInfo, LogLevelWarn, LogLevelError}
+
+// LogConfig controls the logging of the libraries that have levels: Gin
+// prints its debug output, such as the registered routes, only at
+// LogLevelDebug, and Asynq logs at Level and above.
+type LogConfig struct {
+	Level string
+}
+
+// validate checks the level.
+func (l LogConfig) validate() error {
+	if !slices.Contains(logLevels, l.Level) {
+		return fmt.Errorf("invalid LOG_LEVEL %q: want one of %s", l.Level, strings.Join(logLevels, ", "))
+	}
+	return nil
+}
+
+// Lists lists the admin listings whose page size is configurable: job
+// history and dead jobs, email suppressions, and webhook deliveries.
+var Lists = []string{"jobs", "suppressions", "deliveries"}
+
+// ListConfig holds the page sizes of admin listings, keyed by listing.
+type ListConfig struct {
+	// PageSizes are used when a request asks for none or too many.
+	PageSizes map[string]int
+	// MaxPageSizes are the most a request may ask for.
+	MaxPageSizes map[string]int
+}
+
+// PageSize bounds the page size of a listing.
+type PageSize struct {
+	Default int
+	Max     int
+}
+
+// PageSize returns the page size of a listing.
+func (l ListConfig) PageSize(list string) PageSize {
+	return PageSize{Default: l.PageSizes[list], Max: l.MaxPageSizes[list]}
+}
+
+// Of parses the page size a request asked for, returning the default if
+// it is missing or out of range.
+func (p PageSize) Of(requested string) int {
+	size, err := strconv.Atoi(requested)
+	if err != nil || size < 1 || size > p.Max {
+		return p.Default
+	}
+	return size
+}
+
+// validate checks that the maps only name known listings and that each
+// default is positive and within its maximum.
+func (l ListConfig) validate() error {
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(l.PageSizes)) {
+		if !slices.Contains(Lists, name) {
+			errs = append(errs, fmt.Errorf("invalid LIST_PAGE_SIZES list %q: want one of %s", name, strings.Join(Lists, ", ")))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(l.MaxPageSizes)) {
+		if !slices.Contains(Lists, name) {
+			errs = append(errs, fmt.Errorf("invalid LIST_MAX_PAGE_SIZES list %q: want one of %s", name, strings.Join(Lists, ", ")))
+		}
+	}
+	for _, name := range Lists {
+		if size := l.PageSize(name); size.Default < 1 || size.Default > size.Max {
+			errs = append(errs, fmt.Errorf("page size of %s (%d) must be from 1 to its maximum (%d)", name, size.Default, size.Max))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// StartupConfig controls how long the server and worker wait for Postgres
+// and Redis at startup.
+type StartupConfig struct {
+	// MaxWait bounds the wait for each dependency.
+	MaxWait time.Duration
+	// RetryDelay is the delay after the first failed attempt; it doubles
+	// with each further one, up to 30 seconds.
+	RetryDelay time.Duration
+}
+
+// BodyLogConfig controls the debug log of request and response bodies.
+// Bodies are logged for a random SampleRate share of requests and for
+// every request to one of Routes, e.g. "/api/v1/admin/webhooks/:id".
+type BodyLogConfig struct {
+	SampleRate float64
+	Routes     []string
+	// MaxSize is the largest body, in bytes, that is logged.
+	MaxSize int
+}
+
+// Enabled reports whether any bodies are logged.
+func (b BodyLogConfig) Enabled() bool {
+	return b.SampleRate > 0 || len(b.Routes) > 0
+}
+
+// validate checks the sample rate.
+func (b BodyLogConfig) validate() error {
+	if b.SampleRate < 0 || b.SampleRate > 1 {
+		return fmt.Errorf("invalid BODY_LOG_SAMPLE_RATE %v: want a value from 0 to 1", b.SampleRate)
+	}
+	return nil
+}
+
+// validate checks that the wait and delay are positive.
+func (s StartupConfig) validate() error {
+	var errs []error
+	if s.MaxWait <= 0 {
+		errs = append(errs, fmt.Errorf("invalid STARTUP_MAX_WAIT %v: want a positive duration", s.MaxWait))
+	}
+	if s.RetryDelay <= 0 {
+		errs = append(errs, fmt.Errorf("invalid STARTUP_RETRY_DELAY %v: want a positive duration", s.RetryDelay))
+	}
+	return errors.Join(errs...)
+}
+
+// validate checks the storage backend.
+func (a AuditConfig) validate() error {
+	switch a.Storage {
+	case AuditStorageDatabase, AuditStorageLog:
+	default:
+		return fmt.Errorf("invalid AUDIT_STORAGE %q: want %s or %s", a.Storage, AuditStorageDatabase, AuditStorageLog)
+	}
+	return nil
+}
+
+// validate checks the encryption key's length and the IP filter lists.
+func (s SecurityConfig) validate() error {
+	var errs []error
+	if len(s.EncryptionKey) < encryption.MinSecretLength {
+		errs = append(errs, fmt.Errorf("ENCRYPTION_KEY is too weak: want at least %d characters", encryption.MinSecretLength))
+	}
+	lists := []struct {
+		name     string
+		networks []string
+	}{
+		{"IP_ALLOWLIST", s.IPAllowlist},
+		{"IP_DENYLIST", s.IPDenylist},
+		{"ADMIN_IP_ALLOWLIST", s.AdminIPAllowlist},
+		{"ADMIN_IP_DENYLIST", s.AdminIPDenylist},
+	}
+	for _, list := range lists {
+		errs = append(errs, validateNetworks(list.name, list.networks))
+	}
+	return errors.Join(errs...)
+}
+
+// validateNetworks checks that every entry of the named list is an IP or a
+// CIDR.
+func validateNetworks(name string, networks []string) error {
+	var errs []error
+	for _, network := range networks {
+		if _, _, err := net.ParseCIDR(network); err != nil && net.ParseIP(network) == nil {
+			errs = append(errs, fmt.Errorf("invalid %s entry %q: want an IP or CIDR", name, network))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Problems lists everything wrong with a configuration, so all of it can
+// be fixed at once.
+type Problems []error
+
+// add appends err, or the errors it joins, if it is not nil.
+func (p Problems) add(err error) Problems {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, err := range joined.Unwrap() {
+			p = p.add(err)
+		}
+		return p
+	}
+	if err != nil {
+		return append(p, err)
+	}
+	return p
+}
+
+func (p Problems) Error() string {
+	var b strings.Builder
+	b.WriteString("invalid configuration:")
+	for _, err := range p {
+		b.WriteString("\n  - " + err.Error())
+	}
+	return b.String()
+}
+
+func (p Problems) Unwrap() []error {
+	return p
+}
+
+// Load reads the configuration from the environment. A .env file in the
+// working directory is loaded first if present, then the YAML or TOML file
+// at path unless it is empty, and its overlay for ENV if there is one.
+// overrides, e.g. from command-line flags, take precedence over all, then
+// real environment variables, .env, the overlay, and the file it extends.
+// Keys of the files that set no known variable are logged. The process
+// environment is not changed, so loads may run concurrently and a reload
+// sees values removed from the files.
+func Load(path string, overrides map[string]string) (*Config, error) {
+	l := newLoader(overrides)
+	dotenv, err := godotenv.Read()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load .env file: %w", err)
+	}
+	l.setDefaults(dotenv)
+	var files []*configFile
+	if path != "" {
+		file, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		env, ok := l.values["ENV"]
+		if !ok {
+			env = cmp.Or(file.values["ENV"], "development")
+		}
+		overlay, err := readOverlay(path, env)
+		if err != nil {
+			return nil, err
+		}
+		if overlay != nil {
+			l.setDefaults(overlay.values)
+			files = append(files, overlay)
+		}
+		l.setDefaults(file.values)
+		files = append(files, file)
+	}
+	if err := l.readSecretFiles(); err != nil {
+		return nil, err
+	}
+
+	env := l.getEnv("ENV", "development")
+	headers := HeadersConfig{
+		ContentSecurityPolicy: relaxedCSP,
+		FrameOptions:          "SAMEORIGIN",
+		ReferrerPolicy:        "strict-origin-when-cross-origin",
+	}
+	if env == "production" {
+		headers = HeadersConfig{
+			ContentSecurityPolicy: strictCSP,
+			FrameOptions:          "DENY",
+			ReferrerPolicy:        "no-referrer",
+			HSTSMaxAge:            hstsPreloadMinAge,
+		}
+	}
+
+	cfg := &Config{
+		Server: ServerConfig{
+			Port:        l.getEnvInt("PORT", 3690),
+			Env:         env,
+			MaxBodySize: int64(l.getEnvInt("MAX_BODY_SIZE", 1<<20)),
+			RequestTimeouts: l.getEnvDurationMap("REQUEST_TIMEOUTS", map[string]time.Duration{
+				"api":   15 * time.Second,
+				"admin": 30 * time.Second,
+			}),
+			TrustedProxies:       l.getEnvList("TRUSTED_PROXIES", nil),
+			RealIPHeader:         l.getEnv("REAL_IP_HEADER", "X-Forwarded-For"),
+			SlowRequestThreshold: l.getEnvDuration("SLOW_REQUEST_THRESHOLD", 2*time.Second),
+			ShutdownTimeout:      l.getEnvDuration("SERVER_SHUTDOWN_TIMEOUT", 30*time.Second),
+			ReadTimeout:          l.getEnvDuration("SERVER_READ_TIMEOUT", 30*time.Second),
+			ReadHeaderTimeout:    l.getEnvDuration("SERVER_READ_HEADER_TIMEOUT", 10*time.Second),
+			WriteTimeout:         l.getEnvDuration("SERVER_WRITE_TIMEOUT", time.Minute),
+			IdleTimeout:          l.getEnvDuration("SERVER_IDLE_TIMEOUT", 2*time.Minute),
+			MaxHeaderBytes:       l.getEnvInt("SERVER_MAX_HEADER_BYTES", 1<<20),
+			PprofEnabled:         l.getEnvBool("PPROF_ENABLED", false),
+			SocketPath:           l.getEnv("SERVER_SOCKET", ""),
+			SocketMode:           l.getEnv("SERVER_SOCKET_MODE", "0660"),
+			HTTP2:                l.getEnvBool("SERVER_HTTP2", true),
+			H2C:                  l.getEnvBool("SERVER_H2C", false),
+			GracefulRestart:      l.getEnvBool("SERVER_GRACEFUL_RESTART", false),
+		},
+		TLS: TLSConfig{
+			CertFile:     l.getEnv("TLS_CERT_FILE", ""),
+			KeyFile:      l.getEnv("TLS_KEY_FILE", ""),
+			ACMEDomains:  l.getEnvList("TLS_ACME_DOMAINS", nil),
+			ACMEEmail:    l.getEnv("TLS_ACME_EMAIL", ""),
+			ACMECacheDir: l.getEnv("TLS_ACME_CACHE_DIR", "data/acme"),
+			MinVersion:   l.getEnv("TLS_MIN_VERSION", "1.2"),
+			CipherSuites: l.getEnvList("TLS_CIPHER_SUITES", nil),
+		},
+		CORS: CORSConfig{
+			AllowedOrigins:   l.getEnvList("CORS_ALLOWED_ORIGINS", nil),
+			AllowedMethods:   l.getEnvList("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "PATCH", "DELETE"}),
+			AllowedHeaders:   l.getEnvList("CORS_ALLOWED_HEADERS", []string{"Content-Type", "X-Api-Key", "X-Request-ID", "Last-Event-ID"}),
+			AllowCredentials: l.getEnvBool("CORS_ALLOW_CREDENTIALS", false),
+			MaxAge:           l.getEnvDuration("CORS_MAX_AGE", 12*time.Hour),
+		},
+		Headers: HeadersConfig{
+			ContentSecurityPolicy: l.getEnvAllowEmpty("CONTENT_SECURITY_POLICY", headers.ContentSecurityPolicy),
+			FrameOptions:          strings.ToUpper(l.getEnvAllowEmpty("FRAME_OPTIONS", headers.FrameOptions)),
+			ReferrerPolicy:        l.getEnvAllowEmpty("REFERRER_POLICY", headers.ReferrerPolicy),
+			HSTSMaxAge:            l.getEnvDuration("HSTS_MAX_AGE", headers.HSTSMaxAge),
+			HSTSIncludeSubdomains: l.getEnvBool("HSTS_INCLUDE_SUBDOMAINS", false),
+			HSTSPreload:           l.getEnvBool("HSTS_PRELOAD", false),
+		},
+		RateLimit: RateLimitConfig{
+			Requests: l.getEnvIntMap("RATE_LIMIT_REQUESTS", map[string]int{
+				"api":      1000,
+				"admin":    300,
+				"webhooks": 600,
+			}),
+			Windows: l.getEnvDurationMap("RATE_LIMIT_WINDOWS", map[string]time.Duration{
+				"api":      time.Hour,
+				"admin":    time.Minute,
+				"webhooks": time.Minute,
+			}),
+			Bursts: l.getEnvIntMap("RATE_LIMIT_BURSTS", nil),
+		},
+		Database: DatabaseConfig{
+			URL: l.getEnv("DATABASE_URL", fmt.Sprintf(
+				"postgres://%s:%s@%s:%s/%s?sslmode=disable",
+				l.getEnv("POSTGRES_USER", "venio"),
+				l.getEnv("POSTGRES_PASSWORD", ""),
+				l.getEnv("POSTGRES_HOST", "localhost"),
+				l.getEnv("POSTGRES_PORT", "5432"),
+				l.getEnv("POSTGRES_DB", "venio"),
+			)),
+			ReplicaURL:         l.getEnv("DATABASE_REPLICA_URL", ""),
+			StatementTimeout:   l.getEnvDuration("DATABASE_STATEMENT_TIMEOUT", 30*time.Second),
+			SlowQueryThreshold: l.getEnvDuration("SLOW_QUERY_THRESHOLD", 500*time.Millisecond),
+			AutoMigrate:        l.getEnvBool("DATABASE_AUTO_MIGRATE", false),
+		},
+		Redis: RedisConfig{
+			Host:     l.getEnv("REDIS_HOST", "localhost"),
+			Port:     l.getEnvInt("REDIS_PORT", 6379),
+			Password: l.getEnv("REDIS_PASSWORD", ""),
+			DB:       l.getEnvInt("REDIS_DB", 0),
+		},
+		Cache: CacheConfig{
+			TMDBTTL:        l.getEnvDuration("CACHE_TMDB_TTL", 24*time.Hour),
+			ArrProfilesTTL: l.getEnvDuration("CACHE_ARR_PROFILES_TTL", time.Hour),
+			PlexGUIDTTL:    l.getEnvDuration("CACHE_PLEX_GUID_TTL", 7*24*time.Hour),
+			ResponseTTLs: l.getEnvDurationMap("CACHE_RESPONSE_TTLS", map[string]time.Duration{
+				"instances":  5 * time.Minute,
+				"job_queues": 5 * time.Second,
+			}),
+		},
+		Worker: WorkerConfig{
+			Mode: l.getEnv("WORKER_MODE", WorkerModeQueue),
+
+			QueueConcurrency: l.getEnvIntMap("WORKER_QUEUE_CONCURRENCY", map[string]int{
+				"critical": 5,
+				"default":  10,
+				"low":      2,
+			}),
+
+			ShutdownTimeout: l.getEnvDuration("WORKER_SHUTDOWN_TIMEOUT", 30*time.Second),
+			JobStatusTTL:    l.getEnvDuration("JOB_STATUS_TTL", 24*time.Hour),
+
+			JobHistoryRetention: l.getEnvDuration("JOB_HISTORY_RETENTION", 30*24*time.Hour),
+
+			RetryMax:       l.getEnvInt("WORKER_RETRY_MAX", 10),
+			RetryBaseDelay: l.getEnvDuration("WORKER_RETRY_BASE_DELAY", 30*time.Second),
+			RetryMaxDelay:  l.getEnvDuration("WORKER_RETRY_MAX_DELAY", time.Hour),
+
+			ArrRequestTimeout: l.getEnvDuration("ARR_REQUEST_TIMEOUT", 30*time.Second),
+		},
+		Scheduler: SchedulerConfig{
+			Timezone:           l.getEnv("SCHEDULER_TIMEZONE", "UTC"),
+			ArrProfilesRefresh: l.getEnvAllowEmpty("SCHEDULE_ARR_PROFILES_REFRESH", "0 */6 * * *"),
+			WebhookPrune:       l.getEnvAllowEmpty("SCHEDULE_WEBHOOK_PRUNE", "30 3 * * *"),
+			JobHistoryPrune:    l.getEnvAllowEmpty("SCHEDULE_JOB_HISTORY_PRUNE", "45 3 * * *"),
+			AuditPrune:         l.getEnvAllowEmpty("SCHEDULE_AUDIT_PRUNE", "0 4 * * *"),
+		},
+		Outbox: OutboxConfig{
+			PollInterval:   l.getEnvDuration("OUTBOX_POLL_INTERVAL", 2*time.Second),
+			BatchSize:      l.getEnvInt("OUTBOX_BATCH_SIZE", 100),
+			MaxAttempts:    l.getEnvInt("OUTBOX_MAX_ATTEMPTS", 20),
+			RetryBaseDelay: l.getEnvDuration("OUTBOX_RETRY_BASE_DELAY", 10*time.Second),
+			RetryMaxDelay:  l.getEnvDuration("OUTBOX_RETRY_MAX_DELAY", time.Hour),
+			Retention:      l.getEnvDuration("OUTBOX_RETENTION", 7*24*time.Hour),
+		},
+		Webhook: WebhookConfig{
+			Timeout:           l.getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			MaxFailures:       l.getEnvInt("WEBHOOK_MAX_FAILURES", 50),
+			DeliveryRetention: l.getEnvDuration("WEBHOOK_DELIVERY_RETENTION", 30*24*time.Hour),
+		},
+		Notification: NotificationConfig{
+			Throttle: l.getEnvDurationMap("NOTIFICATION_THROTTLE", map[string]time.Duration{
+				"instance.updated": 10 * time.Minute,
+			}),
+		},
+		Email: EmailConfig{
+			Provider:     l.getEnv("EMAIL_PROVIDER", "log"),
+			From:         l.getEnv("EMAIL_FROM", ""),
+			FromName:     l.getEnv("EMAIL_FROM_NAME", "Venio"),
+			SMTPHost:     l.getEnv("SMTP_HOST", ""),
+			SMTPPort:     l.getEnvInt("SMTP_PORT", 587),
+			SMTPUsername: l.getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: l.getEnv("SMTP_PASSWORD", ""),
+			SMTPTLS:      l.getEnv("SMTP_TLS", "starttls"),
+
+			SendGridAPIKey: l.getEnv("SENDGRID_API_KEY", ""),
+
+			MailgunDomain:  l.getEnv("MAILGUN_DOMAIN", ""),
+			MailgunAPIKey:  l.getEnv("MAILGUN_API_KEY", ""),
+			MailgunBaseURL: l.getEnv("MAILGUN_BASE_URL", ""),
+
+			SESRegion:          l.getEnv("SES_REGION", l.getEnv("AWS_REGION", "")),
+			SESAccessKeyID:     l.getEnv("SES_ACCESS_KEY_ID", l.getEnv("AWS_ACCESS_KEY_ID", "")),
+			SESSecretAccessKey: l.getEnv("SES_SECRET_ACCESS_KEY", l.getEnv("AWS_SECRET_ACCESS_KEY", "")),
+
+			BrandName:            l.getEnv("EMAIL_BRAND_NAME", "Venio"),
+			BrandLogoURL:         l.getEnv("EMAIL_BRAND_LOGO_URL", ""),
+			BrandPrimaryColor:    l.getEnv("EMAIL_BRAND_PRIMARY_COLOR", "#6d28d9"),
+			BrandBackgroundColor: l.getEnv("EMAIL_BRAND_BACKGROUND_COLOR", "#f3f4f6"),
+			TemplateDir:          l.getEnv("EMAIL_TEMPLATE_DIR", ""),
+			FeedbackToken:        l.getEnv("EMAIL_FEEDBACK_TOKEN", ""),
+			FeedbackMaxBodySize:  int64(l.getEnvInt("EMAIL_FEEDBACK_MAX_BODY_SIZE", 5<<20)),
+			APITimeout:           l.getEnvDuration("EMAIL_API_TIMEOUT", 30*time.Second),
+		},
+		I18n: I18nConfig{
+			DefaultLocale: l.getEnv("DEFAULT_LOCALE", "en"),
+		},
+		Security: SecurityConfig{
+			APIKey:        l.getEnv("API_KEY", ""),
+			EncryptionKey: l.getEnv("ENCRYPTION_KEY", ""),
+
+			IPAllowlist:      l.getEnvList("IP_ALLOWLIST", nil),
+			IPDenylist:       l.getEnvList("IP_DENYLIST", nil),
+			AdminIPAllowlist: l.getEnvList("ADMIN_IP_ALLOWLIST", nil),
+			AdminIPDenylist:  l.getEnvList("ADMIN_IP_DENYLIST", nil),
+		},
+		Audit: AuditConfig{
+			Enabled:   l.getEnvBool("AUDIT_ENABLED", true),
+			Storage:   l.getEnv("AUDIT_STORAGE", AuditStorageDatabase),
+			Retention: l.getEnvDuration("AUDIT_RETENTION", 90*24*time.Hour),
+			LogBodies: l.getEnvBool("AUDIT_LOG_BODIES", false),
+			RedactFields: l.getEnvList("AUDIT_REDACT_FIELDS", []string{
+				"api_key", "password", "secret", "token", "settings", "url", "to",
+			}),
+			BufferSize: l.getEnvInt("AUDIT_BUFFER_SIZE", 1000),
+		},
+		ErrorTracking: ErrorTrackingConfig{
+			DSN:         l.getEnv("ERROR_TRACKING_DSN", ""),
+			Environment: l.getEnv("ERROR_TRACKING_ENVIRONMENT", env),
+		},
+		Log: LogConfig{
+			Level: strings.ToLower(l.getEnv("LOG_LEVEL", LogLevelInfo)),
+		},
+		BodyLog: BodyLogConfig{
+			SampleRate: l.getEnvFloat("BODY_LOG_SAMPLE_RATE", 0),
+			Routes:     l.getEnvList("BODY_LOG_ROUTES", nil),
+			MaxSize:    l.getEnvInt("BODY_LOG_MAX_SIZE", 4<<10),
+		},
+		Startup: StartupConfig{
+			MaxWait:    l.getEnvDuration("STARTUP_MAX_WAIT", time.Minute),
+			RetryDelay: l.getEnvDuration("STARTUP_RETRY_DELAY", time.Second),
+		},
+		Lists: ListConfig{
+			PageSizes: l.getEnvIntMap("LIST_PAGE_SIZES", map[string]int{
+				"jobs":         20,
+				"suppressions": 50,
+				"deliveries":   50,
+			}),
+			MaxPageSizes: l.getEnvIntMap("LIST_MAX_PAGE_SIZES", map[string]int{
+				"jobs":         100,
+				"suppressions": 200,
+				"deliveries":   500,
+			}),
+		},
+	}
+
+	cfg.values = l.resolved
+	for _, file := range files {
+		file.warnUnknown(l.read)
+	}
+
+	problems := Problems(l.errs)
+	for _, err := range []error{
+		cfg.Server.validate(),
+		cfg.TLS.validate(),
+		cfg.CORS.validate(),
+		cfg.Headers.validate(),
+		cfg.RateLimit.validate(),
+		cfg.Database.validate(),
+		cfg.Redis.validate(),
+		cfg.Worker.validate(),
+		cfg.Scheduler.validate(),
+		cfg.Outbox.validate(),
+		cfg.Email.validate(),
+		cfg.Security.validate(),
+		cfg.Audit.validate(),
+		cfg.ErrorTracking.validate(),
+		cfg.Log.validate(),
+		cfg.BodyLog.validate(),
+		cfg.Startup.validate(),
+		cfg.Lists.validate(),
+	} {
+		problems = problems.add(err)
+	}
+	if len(problems) > 0 {
+		return nil, problems
+	}
+
+	return cfg, nil
+}
+
+// loader holds the state of a single Load, so loads do not share it and
+// the process environment is left alone.
+type loader struct {
+	// values holds the variables of every source, merged by precedence.
+	values map[string]string
+	// read records the variables Load reads, so that keys in a
+	// configuration file that set none of them can be reported.
+	read map[string]bool
+	// resolved records the value Load settled on for each variable it
+	// read, defaults included.
+	resolved map[string]string
+	// errs collects the values that could not be parsed, to be reported
+	// with the other problems of the configuration.
+	errs []error
+}
+
+// newLoader creates a loader starting from overrides and the process
+// environment.
+func newLoader(overrides map[string]string) *loader {
+	values := map[string]string{}
+	for _, entry := range os.Environ() {
+		if name, value, ok := strings.Cut(entry, "="); ok {
+			values[name] = value
+		}
+	}
+	maps.Copy(values, overrides)
+	return &loader{values: values, read: map[string]bool{}, resolved: map[string]string{}}
+}
+
+// setDefaults adds the variables in values that are not already set, so
+// the sources added earlier take precedence.
+func (l *loader) setDefaults(values map[string]string) {
+	for name, value := range values {
+		if _, ok := l.values[name]; !ok {
+			l.values[name] = value
+		}
+	}
+}
+
+// lookupEnvSet returns the value of key and whether it is set, recording
+// that it was read.
+func (l *loader) lookupEnvSet(key string) (string, bool) {
+	l.read[key] = true
+	value, ok := l.values[key]
+	return value, ok
+}
+
+// invalidValue records that value of key could not be parsed.
+func (l *loader) invalidValue(key, value, want string) {
+	l.errs = append(l.errs, fmt.Errorf("invalid %s %q: want %s", key, value, want))
+}
+
+// lookupEnv returns the value of key, or "" when it is unset.
+func (l *loader) lookupEnv(key string) string {
+	value, _ := l.lookupEnvSet(key)
+	return value
+}
+
+// getEnv returns the value of key or fallback when it is unset or empty.
+func (l *loader) getEnv(key, fallback string) string {
+	if value := l.lookupEnv(key); value != "" {
+		return resolve(l, key, value)
+	}
+	return resolve(l, key, fallback)
+}
+
+// getEnvAllowEmpty is like getEnv but honors an explicitly empty value, so
+// that defaults can be switched off by setting the variable to "".
+func (l *loader) getEnvAllowEmpty(key, fallback string) string {
+	if value, ok := l.lookupEnvSet(key); ok {
+		return resolve(l, key, value)
+	}
+	return resolve(l, key, fallback)
+}
+
+// getEnvInt returns key parsed as an int, or fallback when unset or invalid.
+func (l *loader) getEnvInt(key string, fallback int) int {
+	raw := l.lookupEnv(key)
+	if raw == "" {
+		return resolve(l, key, fallback)
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		l.invalidValue(key, raw, "an integer")
+		return resolve(l, key, fallback)
+	}
+	return resolve(l, key, value)
+}
+
+// getEnvFloat returns key parsed as a float64, or fallback when unset or
+// invalid.
+func (l *loader) getEnvFloat(key string, fallback float64) float64 {
+	raw := l.lookupEnv(key)
+	if raw == "" {
+		return resolve(l, key, fallback)
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		l.invalidValue(key, raw, "a number")
+		return resolve(l, key, fallback)
+	}
+	return resolve(l, key, value)
+}
+
+// getEnvBool returns key parsed as a bool ("true", "1", "false", ...), or
+// fallback when unset or invalid.
+func (l *loader) getEnvBool(key string, fallback bool) bool {
+	raw := l.lookupEnv(key)
+	if raw == "" {
+		return resolve(l, key, fallback)
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		l.invalidValue(key, raw, "true or false")
+		return resolve(l, key, fallback)
+	}
+	return resolve(l, key, value)
+}
+
+// getEnvList parses key as a comma-separated list, dropping empty entries.
+// An unset or empty variable returns fallback.
+func (l *loader) getEnvList(key string, fallback []string) []string {
+	var values []string
+	for _, value := range strings.Split(l.lookupEnv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return resolve(l, key, fallback)
+	}
+	return resolve(l, key, values)
+}
+
+// getEnvIntMap parses key as comma-separated name=int pairs
+// (e.g. "critical=5,default=10"). Listed names override fallback; invalid
+// entries are skipped.
+func (l *loader) getEnvIntMap(key string, fallback map[string]int) map[string]int {
+	result := make(map[string]int, len(fallback))
+	for name, value := range fallback {
+		result[name] = value
+	}
+
+	for _, pair := range strings.Split(l.lookupEnv(key), ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		name, raw, ok := strings.Cut(pair, "=")
+		value, err := strconv.Atoi(strings.TrimSpace(raw))
+		if !ok || err != nil {
+			l.invalidValue(key, pair, "name=integer")
+			continue
+		}
+		result[strings.TrimSpace(name)] = value
+	}
+	return resolve(l, key, result)
+}
+
+// getEnvDuration returns key parsed as a time.Duration, or fallback when
+// unset or invalid.
+func (l *loader) getEnvDuration(key string, fallback time.Duration) time.Duration {
+	raw := l.lookupEnv(key)
+	if raw == "" {
+		return resolve(l, key, fallback)
+	}
+	value, err := time.ParseDuration(raw)
+	if err != nil {
+		l.invalidValue(key, raw, "a duration such as 30s")
+		return resolve(l, key, fallback)
+	}
+	return resolve(l, key, value)
+}
+
+// getEnvDurationMap parses key as comma-separated name=duration pairs
+// (e.g. "instance.updated=10m,*=1m"). Listed names override fallback;
+// invalid entries are skipped.
+func (l *loader) getEnvDurationMap(key string, fallback map[string]time.Duration) map[string]time.Duration {
+	result := make(map[string]time.Duration, len(fallback))
+	for name, value := range fallback {
+		result[name] = value
+	}
+
+	for _, pair := range strings.Split(l.lookupEnv(key), ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		name, raw, ok := strings.Cut(pair, "=")
+		value, err := time.ParseDuration(strings.TrimSpace(raw))
+		if !ok || err != nil {
+			l.invalidValue(key, pair, "name=duration such as api=30s")
+			continue
+		}
+		result[strings.TrimSpace(name)] = value
+	}
+	return resolve(l, key, result)
+}
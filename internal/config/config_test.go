@@ -0,0 +1,145 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const testEncryptionKey = "0123456789abcdef0123456789abcdef"
+
+// inTempDir runs the test in an empty directory, so no .env file is read
+// unless the test writes one.
+func inTempDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	return dir
+}
+
+// writeFile writes content to name in dir and returns its path.
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	dir := inTempDir(t)
+	t.Setenv("ENCRYPTION_KEY", testEncryptionKey)
+	t.Setenv("ENV", "production")
+	t.Setenv("PORT", "1001")
+	t.Setenv("REDIS_HOST", "environment")
+	writeFile(t, dir, ".env", "PORT=1002\nREDIS_HOST=dotenv\nREDIS_PORT=2002\n")
+	path := writeFile(t, dir, "config.yml", "port: 1004\nredis:\n  port: 2004\n  db: 4\ndefault_locale: fr\n")
+	writeFile(t, dir, "config.production.yml", "port: 1003\nredis:\n  port: 2003\n  db: 3\n")
+
+	cfg, err := Load(path, map[string]string{"PORT": "1000"})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"override over environment", cfg.Server.Port, 1000},
+		{"environment over .env", cfg.Redis.Host, "environment"},
+		{".env over overlay", cfg.Redis.Port, 2002},
+		{"overlay over file", cfg.Redis.DB, 3},
+		{"file over default", cfg.I18n.DefaultLocale, "fr"},
+		{"default", cfg.Audit.Storage, AuditStorageDatabase},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestLoadLeavesEnvironment(t *testing.T) {
+	dir := inTempDir(t)
+	t.Setenv("ENCRYPTION_KEY", testEncryptionKey)
+	writeFile(t, dir, ".env", "REDIS_PORT=2002\n")
+	path := writeFile(t, dir, "config.yml", "default_locale: fr\n")
+	secret := writeFile(t, dir, "api_key", "s3cret\n")
+	t.Setenv("API_KEY_FILE", secret)
+
+	cfg, err := Load(path, nil)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Security.APIKey != "s3cret" {
+		t.Errorf("APIKey = %q, want the contents of API_KEY_FILE", cfg.Security.APIKey)
+	}
+	for _, key := range []string{"REDIS_PORT", "DEFAULT_LOCALE", "API_KEY"} {
+		if value, ok := os.LookupEnv(key); ok {
+			t.Errorf("Load() set %s=%q in the environment", key, value)
+		}
+	}
+
+	// A value removed from the file falls back to its default on reload.
+	writeFile(t, dir, "config.yml", "")
+	cfg, err = Load(path, nil)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.I18n.DefaultLocale != "en" {
+		t.Errorf("DefaultLocale after reload = %q, want the default", cfg.I18n.DefaultLocale)
+	}
+}
+
+func TestLoadSecretFileConflict(t *testing.T) {
+	dir := inTempDir(t)
+	t.Setenv("ENCRYPTION_KEY", testEncryptionKey)
+	path := writeFile(t, dir, "config.yml", "api_key: from-file\n")
+	t.Setenv("API_KEY_FILE", writeFile(t, dir, "api_key", "s3cret"))
+
+	_, err := Load(path, nil)
+	if err == nil || !strings.Contains(err.Error(), "both API_KEY and API_KEY_FILE are set") {
+		t.Fatalf("Load() error = %v, want a conflict", err)
+	}
+}
+
+func TestLoadConcurrent(t *testing.T) {
+	dir := inTempDir(t)
+	t.Setenv("ENCRYPTION_KEY", testEncryptionKey)
+	path := writeFile(t, dir, "config.yml", "port: 1004\n")
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cfg, err := Load(path, nil)
+			if err != nil {
+				t.Errorf("Load() error = %v", err)
+				return
+			}
+			if cfg.Server.Port != 1004 {
+				t.Errorf("Port = %d, want 1004", cfg.Server.Port)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// redacted replaces the values of secrets in Redacted.
+const redacted = "[REDACTED]"
+
+// resolve records value as the effective value of key in l and returns
+// it.
+func resolve[T any](l *loader, key string, value T) T {
+	l.resolved[key] = formatValue(value)
+	return value
+}
+
+// formatValue formats a value the way it is written in the environment.
+func formatValue(value any) string {
+	switch value := value.(type) {
+	case []string:
+		return strings.Join(value, ",")
+	case map[string]int:
+		return formatPairs(value)
+	case map[string]time.Duration:
+		return formatPairs(value)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// formatPairs formats a map as sorted name=value pairs.
+func formatPairs[V any](values map[string]V) string {
+	pairs := make([]string, 0, len(values))
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", name, values[name]))
+	}
+	return strings.Join(pairs, ",")
+}
+
+// Redacted returns the variables the configuration was loaded from with
+// their effective values, defaults included. Secrets that are set read
+// [REDACTED]; database URLs only lose their password.
+func (c *Config) Redacted() map[string]string {
+	values := maps.Clone(c.values)
+	for _, key := range secretKeys {
+		if values[key] != "" {
+			values[key] = redacted
+		}
+	}
+	for _, key := range []string{"DATABASE_URL", "DATABASE_REPLICA_URL"} {
+		if u, err := url.Parse(c.values[key]); err == nil && u.Scheme != "" {
+			values[key] = u.Redacted()
+		}
+	}
+	return values
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// fileKey is a key of a configuration file that holds a value.
+type fileKey struct {
+	// path is the key as written, e.g. "server.shutdown_timeout".
+	path string
+	// names are the variables the key and its parents set.
+	names []string
+}
+
+// configFile holds the variables set by a configuration file.
+type configFile struct {
+	path   string
+	values map[string]string
+	keys   []fileKey
+}
+
+// readFile reads a YAML or TOML configuration file, chosen by extension.
+// Nested keys are joined with underscores and upper-cased to the variable
+// they set: server.shutdown_timeout sets SERVER_SHUTDOWN_TIMEOUT. Lists
+// become comma-separated values, and tables of plain values name=value
+// pairs, so request_timeouts: {api: 15s} sets REQUEST_TIMEOUTS=api=15s.
+func readFile(path string) (*configFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	tree := map[string]any{}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &tree)
+	case ".toml":
+		err = toml.Unmarshal(data, &tree)
+	default:
+		return nil, fmt.Errorf("unsupported config file %s: want .yaml, .yml, or .toml", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	file := &configFile{path: path, values: map[string]string{}}
+	file.add(tree, "", "", nil)
+	slices.SortFunc(file.keys, func(a, b fileKey) int { return strings.Compare(a.path, b.path) })
+	return file, nil
+}
+
+// readOverlay reads the overlay of the configuration file at path for env,
+// named after the file with env before its extension: config.production.yml
+// for config.yml. It returns nil if there is no overlay.
+func readOverlay(path, env string) (*configFile, error) {
+	ext := filepath.Ext(path)
+	overlay := strings.TrimSuffix(path, ext) + "." + env + ext
+	if _, err := os.Stat(overlay); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return readFile(overlay)
+}
+
+// add records node, found at path and setting name, and its children.
+func (f *configFile) add(node any, path, name string, parents []string) {
+	table, ok := node.(map[string]any)
+	if !ok {
+		f.values[name] = fileValue(node)
+		f.keys = append(f.keys, fileKey{path: path, names: append(parents, name)})
+		return
+	}
+
+	if name != "" {
+		if pairs, ok := filePairs(table); ok {
+			f.values[name] = pairs
+		}
+		parents = append(parents, name)
+	}
+	for key, child := range table {
+		childPath, childName := key, strings.ToUpper(key)
+		if name != "" {
+			childPath, childName = path+"."+key, name+"_"+childName
+		}
+		f.add(child, childPath, childName, slices.Clone(parents))
+	}
+}
+
+// warnUnknown logs the keys that set none of the variables in read, which
+// are most likely misspelled.
+func (f *configFile) warnUnknown(read map[string]bool) {
+	for _, key := range f.keys {
+		if !slices.ContainsFunc(key.names, func(name string) bool { return read[name] }) {
+			log.Printf("Unknown key %s in %s", key.path, f.path)
+		}
+	}
+}
+
+// fileValue formats a value as an environment variable.
+func fileValue(node any) string {
+	switch node := node.(type) {
+	case nil:
+		return ""
+	case []any:
+		values := make([]string, len(node))
+		for i, value := range node {
+			values[i] = fileValue(value)
+		}
+		return strings.Join(values, ",")
+	default:
+		return fmt.Sprint(node)
+	}
+}
+
+// filePairs formats a table of plain values as name=value pairs, or
+// reports false if it has nested tables.
+func filePairs(table map[string]any) (string, bool) {
+	pairs := make([]string, 0, len(table))
+	for _, key := range slices.Sorted(maps.Keys(table)) {
+		if _, ok := table[key].(map[string]any); ok {
+			return "", false
+		}
+		pairs = append(pairs, key+"="+fileValue(table[key]))
+	}
+	return strings.Join(pairs, ","), true
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package config
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+)
+
+// Value holds a configuration value that can be replaced while the
+// process runs. Middleware that should pick up reloads reads it on every
+// request.
+type Value[T any] struct {
+	current atomic.Pointer[T]
+}
+
+// NewValue returns a Value holding v.
+func NewValue[T any](v T) *Value[T] {
+	value := &Value[T]{}
+	value.Store(v)
+	return value
+}
+
+// Load returns the current value.
+func (v *Value[T]) Load() T {
+	return *v.current.Load()
+}
+
+// Store replaces the value.
+func (v *Value[T]) Store(value T) {
+	v.current.Store(&value)
+}
+
+// Reloader loads the configuration again, from the same sources as at
+// startup, and passes it to its subscribers.
+type Reloader struct {
+	path      string
+	overrides map[string]string
+
+	mu          sync.Mutex
+	subscribers []func(*Config)
+}
+
+// NewReloader creates a reloader for the configuration file at path, or
+// for the environment alone if path is empty, with the overrides passed
+// to Load at startup.
+func NewReloader(path string, overrides map[string]string) *Reloader {
+	return &Reloader{path: path, overrides: overrides}
+}
+
+// Subscribe registers fn to receive every reloaded configuration. fn takes
+// the values that can change while running and ignores the others.
+func (r *Reloader) Subscribe(fn func(*Config)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscribers = append(r.subscribers, fn)
+}
+
+// Reload loads the configuration and passes it to the subscribers. An
+// invalid configuration is returned as an error and not passed on.
+func (r *Reloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cfg, err := Load(r.path, r.overrides)
+	if err != nil {
+		return err
+	}
+	for _, fn := range r.subscribers {
+		fn(cfg)
+	}
+	return nil
+}
+
+// ReloadOnSIGHUP reloads the configuration whenever the process receives
+// SIGHUP.
+func (r *Reloader) ReloadOnSIGHUP() {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+	go func() {
+		for range sigs {
+			if err := r.Reload(); err != nil {
+				log.Printf("Failed to reload configuration, keeping the current one: %v", err)
+				continue
+			}
+			log.Println("Configuration reloaded")
+		}
+	}()
+}
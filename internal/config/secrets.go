@@ -0,0 +1,60 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// secretKeys lists the variables holding credentials. Each can instead be
+// read from the file named by the variable with a _FILE suffix, as with
+// Docker and Kubernetes secrets.
+var secretKeys = []string{
+	"DATABASE_URL",
+	"DATABASE_REPLICA_URL",
+	"POSTGRES_PASSWORD",
+	"REDIS_PASSWORD",
+	"API_KEY",
+	"ENCRYPTION_KEY",
+	"SMTP_USERNAME",
+	"SMTP_PASSWORD",
+	"SENDGRID_API_KEY",
+	"MAILGUN_API_KEY",
+	"SES_ACCESS_KEY_ID",
+	"SES_SECRET_ACCESS_KEY",
+	"AWS_ACCESS_KEY_ID",
+	"AWS_SECRET_ACCESS_KEY",
+	"EMAIL_FEEDBACK_TOKEN",
+	"ERROR_TRACKING_DSN",
+}
+
+// readSecretFiles sets the secret variables that have a _FILE variable to
+// the contents of that file, without the trailing newline. The files are
+// read again when the configuration is reloaded, so rotated secrets are
+// picked up.
+func (l *loader) readSecretFiles() error {
+	values := map[string]string{}
+	for _, key := range secretKeys {
+		path, ok := l.lookupEnvSet(key + "_FILE")
+		if !ok {
+			continue
+		}
+		if _, ok := l.values[key]; ok {
+			return fmt.Errorf("both %s and %s_FILE are set", key, key)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s_FILE: %w", key, err)
+		}
+		values[key] = strings.TrimRight(string(data), "\r\n")
+	}
+	l.setDefaults(values)
+	return nil
+}
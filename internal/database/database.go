@@ -0,0 +1,73 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package database manages the PostgreSQL connection pool.
+package database
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/lusoris/venio/internal/config"
+)
+
+// Connect creates a connection pool and verifies that the database is
+// reachable. Statements are canceled after cfg.StatementTimeout, and
+// queries slower than cfg.SlowQueryThreshold are logged.
+func Connect(ctx context.Context, cfg config.DatabaseConfig) (*pgxpool.Pool, error) {
+	pool, err := newPool(ctx, cfg.URL, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return pool, nil
+}
+
+// ConnectReplica creates a connection pool for cfg.ReplicaURL, or returns
+// nil if it is empty. The replica is not required to be reachable, since
+// reads fall back to the primary; see Replica.
+func ConnectReplica(ctx context.Context, cfg config.DatabaseConfig) (*pgxpool.Pool, error) {
+	if cfg.ReplicaURL == "" {
+		return nil, nil
+	}
+	pool, err := newPool(ctx, cfg.ReplicaURL, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("read replica: %w", err)
+	}
+	return pool, nil
+}
+
+// newPool creates a connection pool for url with the settings of cfg.
+func newPool(ctx context.Context, url string, cfg config.DatabaseConfig) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database URL: %w", err)
+	}
+	if cfg.StatementTimeout > 0 {
+		poolCfg.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10)
+	}
+	if cfg.StatementTimeout > 0 || cfg.SlowQueryThreshold > 0 {
+		poolCfg.ConnConfig.Tracer = queryTracer{
+			timeout:       cfg.StatementTimeout,
+			slowThreshold: cfg.SlowQueryThreshold,
+		}
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection pool: %w", err)
+	}
+	return pool, nil
+}
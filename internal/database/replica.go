@@ -0,0 +1,120 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package database
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// replicaRetryDelay is how long queries go to the primary after the
+// replica could not be reached.
+const replicaRetryDelay = 30 * time.Second
+
+// Replica runs queries on a read replica, falling back to the primary
+// while the replica cannot be reached. Exec always runs on the primary.
+// Replicas lag behind the primary, so it only suits reads that may be
+// slightly stale, such as listings.
+type Replica struct {
+	primary *pgxpool.Pool
+	replica *pgxpool.Pool
+	// downUntil is the Unix time in nanoseconds until which the replica
+	// is skipped.
+	downUntil atomic.Int64
+}
+
+// NewReplica creates a Replica reading from replica, or returns primary
+// if replica is nil.
+func NewReplica(primary, replica *pgxpool.Pool) DBTX {
+	if replica == nil {
+		return primary
+	}
+	return &Replica{primary: primary, replica: replica}
+}
+
+// Exec runs sql on the primary.
+func (r *Replica) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return r.primary.Exec(ctx, sql, args...)
+}
+
+// Query runs sql on the replica, or on the primary if the replica cannot
+// be reached.
+func (r *Replica) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	if !r.available() {
+		return r.primary.Query(ctx, sql, args...)
+	}
+	rows, err := r.replica.Query(ctx, sql, args...)
+	if r.unreachable(ctx, err) {
+		return r.primary.Query(ctx, sql, args...)
+	}
+	return rows, err
+}
+
+// QueryRow runs sql on the replica, or on the primary if the replica
+// cannot be reached.
+func (r *Replica) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	if !r.available() {
+		return r.primary.QueryRow(ctx, sql, args...)
+	}
+	return replicaRow{
+		row: r.replica.QueryRow(ctx, sql, args...),
+		fallback: func(err error) pgx.Row {
+			if !r.unreachable(ctx, err) {
+				return nil
+			}
+			return r.primary.QueryRow(ctx, sql, args...)
+		},
+	}
+}
+
+// available reports whether queries should try the replica.
+func (r *Replica) available() bool {
+	return time.Now().UnixNano() >= r.downUntil.Load()
+}
+
+// unreachable reports whether err means the replica could not be reached,
+// in which case it is skipped for replicaRetryDelay.
+func (r *Replica) unreachable(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	var connectErr *pgconn.ConnectError
+	if !errors.As(err, &connectErr) && !pgconn.SafeToRetry(err) {
+		return false
+	}
+	if r.downUntil.Swap(time.Now().Add(replicaRetryDelay).UnixNano()) < time.Now().UnixNano() {
+		log.Printf("Read replica unreachable, reading from the primary for %v: %v", replicaRetryDelay, err)
+	}
+	return true
+}
+
+// replicaRow is a row from the replica that is read from the primary
+// instead if the replica cannot be reached.
+type replicaRow struct {
+	row      pgx.Row
+	fallback func(err error) pgx.Row
+}
+
+// Scan implements pgx.Row.
+func (r replicaRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	if err == nil {
+		return nil
+	}
+	if row := r.fallback(err); row != nil {
+		return row.Scan(dest...)
+	}
+	return err
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package database
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/lusoris/venio/internal/requestid"
+)
+
+// maxLoggedQuery caps how much of a slow query's SQL is logged.
+const maxLoggedQuery = 300
+
+// timeoutGrace is how much longer than the statement timeout a query may
+// take before its context ends. The server normally cancels it first; the
+// deadline covers connections that stopped responding.
+const timeoutGrace = time.Second
+
+// queryTracer is a pgx.QueryTracer that bounds every query by timeout and
+// logs queries taking longer than slowThreshold with their operation,
+// duration, and request ID. Arguments are left out, since they may hold
+// secrets. A zero timeout or threshold disables it.
+type queryTracer struct {
+	timeout       time.Duration
+	slowThreshold time.Duration
+}
+
+// queryStartKey is the context key of the start of a traced query.
+type queryStartKey struct{}
+
+// noTimeoutKey is the context key set by WithoutQueryTimeout.
+type noTimeoutKey struct{}
+
+// queryStart is what TraceQueryStart remembers for TraceQueryEnd.
+type queryStart struct {
+	time   time.Time
+	sql    string
+	cancel context.CancelFunc
+}
+
+// WithoutQueryTimeout returns a context whose queries are not bounded by
+// DATABASE_STATEMENT_TIMEOUT on the client side, for work such as
+// migrations that may legitimately run longer.
+func WithoutQueryTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noTimeoutKey{}, true)
+}
+
+// TraceQueryStart implements pgx.QueryTracer.
+func (t queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	cancel := context.CancelFunc(func() {})
+	if t.timeout > 0 && ctx.Value(noTimeoutKey{}) == nil {
+		ctx, cancel = context.WithTimeout(ctx, t.timeout+timeoutGrace)
+	}
+	return context.WithValue(ctx, queryStartKey{}, queryStart{time: time.Now(), sql: data.SQL, cancel: cancel})
+}
+
+// TraceQueryEnd implements pgx.QueryTracer.
+func (t queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	start.cancel()
+	elapsed := time.Since(start.time)
+	if t.slowThreshold <= 0 || elapsed < t.slowThreshold {
+		return
+	}
+
+	sql := strings.Join(strings.Fields(start.sql), " ")
+	if len(sql) > maxLoggedQuery {
+		sql = sql[:maxLoggedQuery] + "..."
+	}
+	status := "ok"
+	if data.Err != nil {
+		status = data.Err.Error()
+	}
+	log.Printf("Slow query: %s took %v (%s, request %s): %s",
+		queryOperation(sql), elapsed.Truncate(time.Millisecond), status, requestid.FromContext(ctx), sql)
+}
+
+// queryOperation returns the statement's first keyword, e.g. "SELECT".
+func queryOperation(sql string) string {
+	op, _, _ := strings.Cut(sql, " ")
+	return strings.ToUpper(op)
+}
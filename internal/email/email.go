@@ -0,0 +1,45 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package email renders and sends transactional emails.
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+)
+
+// ErrNoRecipient is returned when a message has no recipient.
+var ErrNoRecipient = errors.New("email has no recipient")
+
+// Message is a rendered email ready to send. Text is required; HTML is
+// optional and sent as an alternative part when present.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Sender delivers email messages.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// parseRecipient validates the recipient of msg.
+func parseRecipient(msg *Message) (*mail.Address, error) {
+	if msg.To == "" {
+		return nil, ErrNoRecipient
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient address %q: %v: %w", msg.To, err, ErrRejected)
+	}
+	return to, nil
+}
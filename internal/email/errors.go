@@ -0,0 +1,109 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package email
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/textproto"
+	"strings"
+)
+
+// Error kinds shared by all providers. Provider errors wrap one of these so
+// callers can react without knowing which provider is configured.
+var (
+	// ErrRejected means the provider refused this message, e.g. an invalid
+	// recipient. Retrying the same message will not help.
+	ErrRejected = errors.New("message rejected")
+	// ErrUnauthorized means the provider credentials are wrong.
+	ErrUnauthorized = errors.New("provider rejected credentials")
+	// ErrRateLimited means the provider asked us to slow down.
+	ErrRateLimited = errors.New("provider rate limit exceeded")
+	// ErrUnavailable means the provider could not be reached or failed
+	// temporarily.
+	ErrUnavailable = errors.New("provider unavailable")
+)
+
+// SendError is a failed send reported by a provider.
+type SendError struct {
+	Provider   string
+	StatusCode int
+	Kind       error
+	Detail     string
+}
+
+// Error implements error.
+func (e *SendError) Error() string {
+	msg := fmt.Sprintf("%s: %v", e.Provider, e.Kind)
+	if e.StatusCode != 0 {
+		msg += fmt.Sprintf(" (status %d)", e.StatusCode)
+	}
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	return msg
+}
+
+// Unwrap returns the error kind.
+func (e *SendError) Unwrap() error {
+	return e.Kind
+}
+
+// IsPermanent reports whether err will recur when the same message is sent
+// again, so retrying it is pointless.
+func IsPermanent(err error) bool {
+	return errors.Is(err, ErrRejected) || errors.Is(err, ErrNoRecipient)
+}
+
+// maxErrorDetail caps the provider response included in a SendError.
+const maxErrorDetail = 300
+
+// httpError maps an unsuccessful HTTP API response to a SendError.
+func httpError(provider string, status int, body []byte) error {
+	var kind error
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		kind = ErrUnauthorized
+	case status == http.StatusTooManyRequests:
+		kind = ErrRateLimited
+	case status >= 400 && status < 500:
+		kind = ErrRejected
+	default:
+		kind = ErrUnavailable
+	}
+
+	detail := strings.TrimSpace(string(body))
+	if len(detail) > maxErrorDetail {
+		detail = detail[:maxErrorDetail]
+	}
+	return &SendError{Provider: provider, StatusCode: status, Kind: kind, Detail: detail}
+}
+
+// transportError wraps a failure to reach a provider.
+func transportError(provider string, err error) error {
+	return &SendError{Provider: provider, Kind: ErrUnavailable, Detail: err.Error()}
+}
+
+// smtpError maps an SMTP reply to a SendError. 5xx replies are permanent
+// except for authentication failures; everything else is transient.
+func smtpError(err error) error {
+	var reply *textproto.Error
+	if !errors.As(err, &reply) {
+		return transportError(ProviderSMTP, err)
+	}
+
+	kind := ErrUnavailable
+	switch {
+	case reply.Code == 530 || reply.Code == 534 || reply.Code == 535:
+		kind = ErrUnauthorized
+	case reply.Code >= 500:
+		kind = ErrRejected
+	}
+	return &SendError{Provider: ProviderSMTP, StatusCode: reply.Code, Kind: kind, Detail: reply.Msg}
+}
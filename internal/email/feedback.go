@@ -0,0 +1,197 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// ErrSuppressed is returned when sending to an address that bounced or
+// complained earlier. It wraps ErrRejected, so the send is not retried.
+var ErrSuppressed = fmt.Errorf("recipient address is suppressed: %w", ErrRejected)
+
+// ErrInvalidFeedback is returned for bounce or complaint reports that
+// cannot be parsed.
+var ErrInvalidFeedback = errors.New("invalid email feedback")
+
+// Feedback kinds.
+const (
+	FeedbackBounce    = "bounce"
+	FeedbackComplaint = "complaint"
+)
+
+// snsHost matches the Amazon SNS endpoints that send subscription
+// confirmations, e.g. sns.eu-west-1.amazonaws.com.
+var snsHost = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+// Feedback is a provider report that an address must not be sent email
+// any more: a permanent bounce or a spam complaint. Temporary failures are
+// not reported.
+type Feedback struct {
+	Email  string
+	Kind   string
+	Detail string
+}
+
+// SuppressionList tells whether an address must not be sent email.
+type SuppressionList interface {
+	IsSuppressed(ctx context.Context, address string) (bool, error)
+}
+
+// SuppressingSender wraps a Sender and refuses messages to suppressed
+// addresses.
+type SuppressingSender struct {
+	next Sender
+	list SuppressionList
+}
+
+// NewSuppressingSender wraps next so it skips addresses on list.
+func NewSuppressingSender(next Sender, list SuppressionList) *SuppressingSender {
+	return &SuppressingSender{next: next, list: list}
+}
+
+// Send implements Sender.
+func (s *SuppressingSender) Send(ctx context.Context, msg *Message) error {
+	to, err := parseRecipient(msg)
+	if err != nil {
+		return err
+	}
+
+	suppressed, err := s.list.IsSuppressed(ctx, to.Address)
+	if err != nil {
+		return err
+	}
+	if suppressed {
+		return fmt.Errorf("%w: %s", ErrSuppressed, to.Address)
+	}
+	return s.next.Send(ctx, msg)
+}
+
+// snsEnvelope is an Amazon SNS HTTP notification. SES publishes bounce and
+// complaint notifications through an SNS topic.
+type snsEnvelope struct {
+	Type         string `json:"Type"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+// sesNotification is the SES bounce or complaint notification carried in
+// an SNS message.
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	Bounce           struct {
+		BounceType        string `json:"bounceType"`
+		BounceSubType     string `json:"bounceSubType"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint struct {
+		ComplaintFeedbackType string `json:"complaintFeedbackType"`
+		ComplainedRecipients  []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+	} `json:"complaint"`
+}
+
+// ParseSESFeedback parses an SNS request carrying an SES notification. A
+// subscription confirmation returns no feedback and the URL that confirms
+// the subscription, which is checked to point at Amazon SNS.
+func ParseSESFeedback(body []byte) (feedback []Feedback, subscribeURL string, err error) {
+	var envelope snsEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrInvalidFeedback, err)
+	}
+
+	switch envelope.Type {
+	case "SubscriptionConfirmation":
+		u, err := url.Parse(envelope.SubscribeURL)
+		if err != nil || u.Scheme != "https" || !snsHost.MatchString(u.Hostname()) {
+			return nil, "", fmt.Errorf("%w: subscribe URL is not an Amazon SNS URL", ErrInvalidFeedback)
+		}
+		return nil, envelope.SubscribeURL, nil
+	case "Notification":
+	default:
+		return nil, "", nil
+	}
+
+	var n sesNotification
+	if err := json.Unmarshal([]byte(envelope.Message), &n); err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrInvalidFeedback, err)
+	}
+
+	switch n.NotificationType {
+	case "Bounce":
+		// Transient bounces such as a full mailbox may succeed later.
+		if n.Bounce.BounceType != "Permanent" {
+			return nil, "", nil
+		}
+		for _, r := range n.Bounce.BouncedRecipients {
+			detail := r.DiagnosticCode
+			if detail == "" {
+				detail = n.Bounce.BounceSubType
+			}
+			feedback = appendFeedback(feedback, r.EmailAddress, FeedbackBounce, detail)
+		}
+	case "Complaint":
+		for _, r := range n.Complaint.ComplainedRecipients {
+			feedback = appendFeedback(feedback, r.EmailAddress, FeedbackComplaint, n.Complaint.ComplaintFeedbackType)
+		}
+	}
+	return feedback, "", nil
+}
+
+// sendGridEvent is one entry of a SendGrid event webhook request.
+type sendGridEvent struct {
+	Email  string `json:"email"`
+	Event  string `json:"event"`
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+// ParseSendGridFeedback parses a SendGrid event webhook request. Bounces
+// and spam reports become feedback; "blocked" bounces are temporary and
+// other events are ignored.
+func ParseSendGridFeedback(body []byte) ([]Feedback, error) {
+	var events []sendGridEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFeedback, err)
+	}
+
+	var feedback []Feedback
+	for _, event := range events {
+		switch {
+		case event.Event == "bounce" && event.Type != "blocked":
+			feedback = appendFeedback(feedback, event.Email, FeedbackBounce, event.Reason)
+		case event.Event == "spamreport":
+			feedback = appendFeedback(feedback, event.Email, FeedbackComplaint, "")
+		}
+	}
+	return feedback, nil
+}
+
+// appendFeedback adds a report for address unless it is not a valid
+// address.
+func appendFeedback(feedback []Feedback, address, kind, detail string) []Feedback {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return feedback
+	}
+	if len(detail) > maxErrorDetail {
+		detail = detail[:maxErrorDetail]
+	}
+	return append(feedback, Feedback{Email: strings.ToLower(parsed.Address), Kind: kind, Detail: detail})
+}
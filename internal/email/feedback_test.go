@@ -0,0 +1,154 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package email
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// snsBody builds an SNS request body.
+func snsBody(t *testing.T, kind, subscribeURL, message string) []byte {
+	t.Helper()
+	body, err := json.Marshal(snsEnvelope{Type: kind, SubscribeURL: subscribeURL, Message: message})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestParseSESFeedbackSubscription(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{"SNS", "https://sns.eu-west-1.amazonaws.com/?Action=ConfirmSubscription&Token=x", false},
+		{"SNS China", "https://sns.cn-north-1.amazonaws.com.cn/?Action=ConfirmSubscription", false},
+		{"SNS with port", "https://sns.us-east-1.amazonaws.com:443/?Action=ConfirmSubscription", false},
+		{"plain HTTP", "http://sns.eu-west-1.amazonaws.com/?Action=ConfirmSubscription", true},
+		{"other host", "https://example.com/?Action=ConfirmSubscription", true},
+		{"other AWS service", "https://s3.eu-west-1.amazonaws.com/", true},
+		{"lookalike suffix", "https://sns.eu-west-1.amazonaws.com.evil.io/", true},
+		{"lookalike prefix", "https://evilsns.eu-west-1.amazonaws.com/", true},
+		{"subdomain", "https://a.sns.eu-west-1.amazonaws.com/", true},
+		{"userinfo", "https://sns.eu-west-1.amazonaws.com@evil.io/", true},
+		{"missing region", "https://sns.amazonaws.com/", true},
+		{"empty", "", true},
+		{"unparsable", "https://%zz", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feedback, subscribeURL, err := ParseSESFeedback(snsBody(t, "SubscriptionConfirmation", tt.url, ""))
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidFeedback) {
+					t.Fatalf("ParseSESFeedback() error = %v, want ErrInvalidFeedback", err)
+				}
+				if subscribeURL != "" {
+					t.Errorf("subscribeURL = %q, want none", subscribeURL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSESFeedback() error = %v", err)
+			}
+			if subscribeURL != tt.url {
+				t.Errorf("subscribeURL = %q, want %q", subscribeURL, tt.url)
+			}
+			if feedback != nil {
+				t.Errorf("feedback = %v, want none", feedback)
+			}
+		})
+	}
+}
+
+func TestParseSESFeedbackNotification(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    []Feedback
+		wantErr bool
+	}{
+		{
+			name: "permanent bounce",
+			body: `{"notificationType":"Bounce","bounce":{"bounceType":"Permanent","bounceSubType":"General",` +
+				`"bouncedRecipients":[{"emailAddress":"User@Example.com","diagnosticCode":"550 no such user"}]}}`,
+			want: []Feedback{{Email: "user@example.com", Kind: FeedbackBounce, Detail: "550 no such user"}},
+		},
+		{
+			name: "bounce without diagnostic code",
+			body: `{"notificationType":"Bounce","bounce":{"bounceType":"Permanent","bounceSubType":"Suppressed",` +
+				`"bouncedRecipients":[{"emailAddress":"user@example.com"}]}}`,
+			want: []Feedback{{Email: "user@example.com", Kind: FeedbackBounce, Detail: "Suppressed"}},
+		},
+		{
+			name: "transient bounce",
+			body: `{"notificationType":"Bounce","bounce":{"bounceType":"Transient",` +
+				`"bouncedRecipients":[{"emailAddress":"user@example.com"}]}}`,
+		},
+		{
+			name: "complaint",
+			body: `{"notificationType":"Complaint","complaint":{"complaintFeedbackType":"abuse",` +
+				`"complainedRecipients":[{"emailAddress":"a@example.com"},{"emailAddress":"b@example.com"}]}}`,
+			want: []Feedback{
+				{Email: "a@example.com", Kind: FeedbackComplaint, Detail: "abuse"},
+				{Email: "b@example.com", Kind: FeedbackComplaint, Detail: "abuse"},
+			},
+		},
+		{
+			name: "invalid address skipped",
+			body: `{"notificationType":"Complaint","complaint":{` +
+				`"complainedRecipients":[{"emailAddress":"not an address"},{"emailAddress":"b@example.com"}]}}`,
+			want: []Feedback{{Email: "b@example.com", Kind: FeedbackComplaint}},
+		},
+		{
+			name: "delivery",
+			body: `{"notificationType":"Delivery"}`,
+		},
+		{
+			name:    "invalid message",
+			body:    `not json`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feedback, subscribeURL, err := ParseSESFeedback(snsBody(t, "Notification", "", tt.body))
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidFeedback) {
+					t.Fatalf("ParseSESFeedback() error = %v, want ErrInvalidFeedback", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSESFeedback() error = %v", err)
+			}
+			if subscribeURL != "" {
+				t.Errorf("subscribeURL = %q, want none", subscribeURL)
+			}
+			if !reflect.DeepEqual(feedback, tt.want) {
+				t.Errorf("feedback = %+v, want %+v", feedback, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSESFeedbackEnvelope(t *testing.T) {
+	if _, _, err := ParseSESFeedback([]byte(`{`)); !errors.Is(err, ErrInvalidFeedback) {
+		t.Errorf("ParseSESFeedback(invalid JSON) error = %v, want ErrInvalidFeedback", err)
+	}
+
+	feedback, subscribeURL, err := ParseSESFeedback(snsBody(t, "UnsubscribeConfirmation", "https://example.com/", ""))
+	if err != nil || feedback != nil || subscribeURL != "" {
+		t.Errorf("ParseSESFeedback(unsubscribe) = %v, %q, %v; want nothing", feedback, subscribeURL, err)
+	}
+}
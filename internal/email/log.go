@@ -0,0 +1,32 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package email
+
+import (
+	"context"
+	"log"
+)
+
+// LogSender logs messages instead of sending them. It is meant for
+// development, where links in verification emails can be copied from the
+// log.
+type LogSender struct{}
+
+// NewLogSender creates a log sender.
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+// Send implements Sender.
+func (s *LogSender) Send(_ context.Context, msg *Message) error {
+	if _, err := parseRecipient(msg); err != nil {
+		return err
+	}
+	log.Printf("Email to %s: %s\n%s", msg.To, msg.Subject, msg.Text)
+	return nil
+}
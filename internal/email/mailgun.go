@@ -0,0 +1,75 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+)
+
+// defaultMailgunBaseURL is Mailgun's US API region. EU domains use
+// https://api.eu.mailgun.net.
+const defaultMailgunBaseURL = "https://api.mailgun.net"
+
+// MailgunSender sends email through the Mailgun messages API.
+type MailgunSender struct {
+	endpoint string
+	apiKey   string
+	from     mail.Address
+	client   *http.Client
+}
+
+// NewMailgunSender creates a Mailgun sender for domain. An empty baseURL
+// selects the US region.
+func NewMailgunSender(baseURL, domain, apiKey string, from mail.Address, client *http.Client) (*MailgunSender, error) {
+	if domain == "" || apiKey == "" {
+		return nil, errors.New("Mailgun domain and API key are required")
+	}
+	if baseURL == "" {
+		baseURL = defaultMailgunBaseURL
+	}
+
+	return &MailgunSender{
+		endpoint: strings.TrimRight(baseURL, "/") + "/v3/" + url.PathEscape(domain) + "/messages",
+		apiKey:   apiKey,
+		from:     from,
+		client:   client,
+	}, nil
+}
+
+// Send implements Sender.
+func (s *MailgunSender) Send(ctx context.Context, msg *Message) error {
+	to, err := parseRecipient(msg)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{
+		"from":    {s.from.String()},
+		"to":      {to.String()},
+		"subject": {msg.Subject},
+		"text":    {msg.Text},
+	}
+	if msg.HTML != "" {
+		form.Set("html", msg.HTML)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to build Mailgun request: %w", err)
+	}
+	req.SetBasicAuth("api", s.apiKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return doRequest(s.client, ProviderMailgun, req)
+}
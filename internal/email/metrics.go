@@ -0,0 +1,101 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package email
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Stats summarizes the sends of an InstrumentedSender since startup.
+type Stats struct {
+	Provider string `json:"provider"`
+	Sent     int64  `json:"sent"`
+	Failed   int64  `json:"failed"`
+	// FailedByKind counts failures by error kind: rejected, unauthorized,
+	// rate_limited, unavailable, or other.
+	FailedByKind map[string]int64 `json:"failed_by_kind"`
+	// AvgLatencyMS is the mean duration of all sends.
+	AvgLatencyMS int64      `json:"avg_latency_ms"`
+	LastError    string     `json:"last_error,omitempty"`
+	LastErrorAt  *time.Time `json:"last_error_at,omitempty"`
+}
+
+// InstrumentedSender wraps a Sender and records send counts, failures by
+// kind, and latency.
+type InstrumentedSender struct {
+	next Sender
+
+	mu           sync.Mutex
+	stats        Stats
+	totalLatency time.Duration
+}
+
+// NewInstrumentedSender wraps next, labeling its stats with provider.
+func NewInstrumentedSender(provider string, next Sender) *InstrumentedSender {
+	return &InstrumentedSender{
+		next:  next,
+		stats: Stats{Provider: provider, FailedByKind: make(map[string]int64)},
+	}
+}
+
+// Send implements Sender.
+func (s *InstrumentedSender) Send(ctx context.Context, msg *Message) error {
+	start := time.Now()
+	err := s.next.Send(ctx, msg)
+	elapsed := time.Since(start)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.totalLatency += elapsed
+	if err != nil {
+		s.stats.Failed++
+		s.stats.FailedByKind[errorKind(err)]++
+		s.stats.LastError = err.Error()
+		now := time.Now()
+		s.stats.LastErrorAt = &now
+	} else {
+		s.stats.Sent++
+	}
+	return err
+}
+
+// Stats returns a snapshot of the collected stats.
+func (s *InstrumentedSender) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.stats
+	stats.FailedByKind = make(map[string]int64, len(s.stats.FailedByKind))
+	for kind, n := range s.stats.FailedByKind {
+		stats.FailedByKind[kind] = n
+	}
+	if total := s.stats.Sent + s.stats.Failed; total > 0 {
+		stats.AvgLatencyMS = (s.totalLatency / time.Duration(total)).Milliseconds()
+	}
+	return stats
+}
+
+// errorKind returns the stats label of a send error.
+func errorKind(err error) string {
+	switch {
+	case errors.Is(err, ErrRejected), errors.Is(err, ErrNoRecipient):
+		return "rejected"
+	case errors.Is(err, ErrUnauthorized):
+		return "unauthorized"
+	case errors.Is(err, ErrRateLimited):
+		return "rate_limited"
+	case errors.Is(err, ErrUnavailable):
+		return "unavailable"
+	default:
+		return "other"
+	}
+}
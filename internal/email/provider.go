@@ -0,0 +1,114 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package email
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"time"
+)
+
+// Provider names accepted by New.
+const (
+	ProviderLog      = "log"
+	ProviderSMTP     = "smtp"
+	ProviderSendGrid = "sendgrid"
+	ProviderMailgun  = "mailgun"
+	ProviderSES      = "ses"
+)
+
+// Config selects and configures an email provider. Only the settings of the
+// selected provider are used.
+type Config struct {
+	Provider string
+	From     string
+	FromName string
+
+	SMTP SMTPConfig
+
+	SendGridAPIKey string
+
+	MailgunDomain  string
+	MailgunAPIKey  string
+	MailgunBaseURL string
+
+	SESRegion          string
+	SESAccessKeyID     string
+	SESSecretAccessKey string
+
+	// APITimeout bounds a single request to an HTTP email API.
+	APITimeout time.Duration
+
+	// Suppressions optionally lists addresses that are not sent email.
+	Suppressions SuppressionList
+}
+
+// New creates the configured provider wrapped with send metrics and, when
+// cfg.Suppressions is set, the suppression check.
+func New(cfg Config) (*InstrumentedSender, error) {
+	sender, err := newProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Suppressions != nil {
+		sender = NewSuppressingSender(sender, cfg.Suppressions)
+	}
+	return NewInstrumentedSender(cfg.Provider, sender), nil
+}
+
+// newProvider creates the sender for cfg.Provider.
+func newProvider(cfg Config) (Sender, error) {
+	if cfg.Provider == ProviderLog {
+		return NewLogSender(), nil
+	}
+
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", cfg.From, err)
+	}
+	if cfg.FromName != "" {
+		from.Name = cfg.FromName
+	}
+	client := &http.Client{Timeout: cfg.APITimeout}
+
+	switch cfg.Provider {
+	case ProviderSMTP:
+		smtpCfg := cfg.SMTP
+		smtpCfg.From, smtpCfg.FromName = cfg.From, cfg.FromName
+		return NewSMTPSender(smtpCfg)
+	case ProviderSendGrid:
+		return NewSendGridSender(cfg.SendGridAPIKey, *from, client)
+	case ProviderMailgun:
+		return NewMailgunSender(cfg.MailgunBaseURL, cfg.MailgunDomain, cfg.MailgunAPIKey, *from, client)
+	case ProviderSES:
+		return NewSESSender(cfg.SESRegion, cfg.SESAccessKeyID, cfg.SESSecretAccessKey, *from, client)
+	default:
+		return nil, fmt.Errorf("unknown email provider %q", cfg.Provider)
+	}
+}
+
+// maxResponseBody caps how much of an API response is read.
+const maxResponseBody = 64 << 10
+
+// doRequest sends req and maps transport failures and non-2xx responses to
+// SendErrors.
+func doRequest(client *http.Client, provider string, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return transportError(provider, err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return httpError(provider, resp.StatusCode, body)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+)
+
+// sendGridURL is the SendGrid v3 mail send endpoint.
+const sendGridURL = "https://api.sendgrid.com/v3/mail/send"
+
+// SendGridSender sends email through the SendGrid v3 API.
+type SendGridSender struct {
+	apiKey string
+	from   mail.Address
+	client *http.Client
+}
+
+// NewSendGridSender creates a SendGrid sender.
+func NewSendGridSender(apiKey string, from mail.Address, client *http.Client) (*SendGridSender, error) {
+	if apiKey == "" {
+		return nil, errors.New("SendGrid API key is required")
+	}
+	return &SendGridSender{apiKey: apiKey, from: from, client: client}, nil
+}
+
+// sendGridAddress is an address in a SendGrid request.
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+// sendGridContent is one body part in a SendGrid request.
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// Send implements Sender.
+func (s *SendGridSender) Send(ctx context.Context, msg *Message) error {
+	to, err := parseRecipient(msg)
+	if err != nil {
+		return err
+	}
+
+	content := []sendGridContent{{Type: "text/plain", Value: msg.Text}}
+	if msg.HTML != "" {
+		content = append(content, sendGridContent{Type: "text/html", Value: msg.HTML})
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"personalizations": []map[string]any{
+			{"to": []sendGridAddress{{Email: to.Address, Name: to.Name}}},
+		},
+		"from":    sendGridAddress{Email: s.from.Address, Name: s.from.Name},
+		"subject": msg.Subject,
+		"content": content,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode SendGrid request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sendGridURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build SendGrid request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	return doRequest(s.client, ProviderSendGrid, req)
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"time"
+)
+
+// sesPath is the SES v2 SendEmail endpoint path.
+const sesPath = "/v2/email/outbound-emails"
+
+// SESSender sends email through the Amazon SES v2 API. Requests are signed
+// with AWS Signature Version 4, so no AWS SDK is needed.
+type SESSender struct {
+	region          string
+	host            string
+	accessKeyID     string
+	secretAccessKey string
+	from            mail.Address
+	client          *http.Client
+}
+
+// NewSESSender creates an SES sender for region.
+func NewSESSender(region, accessKeyID, secretAccessKey string, from mail.Address, client *http.Client) (*SESSender, error) {
+	if region == "" || accessKeyID == "" || secretAccessKey == "" {
+		return nil, errors.New("SES region and credentials are required")
+	}
+
+	return &SESSender{
+		region:          region,
+		host:            "email." + region + ".amazonaws.com",
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		from:            from,
+		client:          client,
+	}, nil
+}
+
+// sesContent is a text value in an SES request.
+type sesContent struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+// Send implements Sender.
+func (s *SESSender) Send(ctx context.Context, msg *Message) error {
+	to, err := parseRecipient(msg)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]sesContent{"Text": {Data: msg.Text, Charset: "UTF-8"}}
+	if msg.HTML != "" {
+		body["Html"] = sesContent{Data: msg.HTML, Charset: "UTF-8"}
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"FromEmailAddress": s.from.String(),
+		"Destination":      map[string][]string{"ToAddresses": {to.String()}},
+		"Content": map[string]any{
+			"Simple": map[string]any{
+				"Subject": sesContent{Data: msg.Subject, Charset: "UTF-8"},
+				"Body":    body,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode SES request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+s.host+sesPath, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build SES request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	s.sign(req, payload, time.Now().UTC())
+
+	return doRequest(s.client, ProviderSES, req)
+}
+
+// sign adds AWS Signature Version 4 headers to req.
+func (s *SESSender) sign(req *http.Request, payload []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	scope := date + "/" + s.region + "/ses/aws4_request"
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	const signedHeaders = "content-type;host;x-amz-date"
+	canonicalRequest := req.Method + "\n" +
+		sesPath + "\n" +
+		"\n" +
+		"content-type:" + req.Header.Get("Content-Type") + "\n" +
+		"host:" + s.host + "\n" +
+		"x-amz-date:" + amzDate + "\n" +
+		"\n" +
+		signedHeaders + "\n" +
+		payloadHash
+
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "ses")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKeyID, scope, signedHeaders, signature))
+}
+
+// hmacSHA256 returns HMAC-SHA256 of data under key.
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// sha256Hex returns the hex-encoded SHA-256 of data.
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,286 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package email
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/lusoris/venio/internal/i18n"
+)
+
+// Template names.
+const (
+	TemplateVerification  = "verification"
+	TemplatePasswordReset = "password_reset"
+	TemplateNotification  = "notification"
+)
+
+// ErrUnknownTemplate is returned when rendering a template that does not
+// exist.
+var ErrUnknownTemplate = errors.New("unknown email template")
+
+// templateNames lists the templates a Renderer loads.
+var templateNames = []string{TemplateVerification, TemplatePasswordReset, TemplateNotification}
+
+// builtinTemplates holds the default templates. Each email consists of
+// "<name>.subject.txt", "<name>.txt", and "<name>.html". The bodies define a
+// "content" block that is rendered inside layout.txt or layout.html together
+// with the partials.
+//
+//go:embed templates
+var builtinTemplates embed.FS
+
+// VerificationData is the data of TemplateVerification.
+type VerificationData struct {
+	Name      string
+	URL       string
+	ExpiresIn time.Duration
+}
+
+// PasswordResetData is the data of TemplatePasswordReset.
+type PasswordResetData struct {
+	Name      string
+	URL       string
+	ExpiresIn time.Duration
+}
+
+// NotificationData is the data of TemplateNotification. URL is optional.
+type NotificationData struct {
+	Name  string
+	Title string
+	Body  string
+	URL   string
+}
+
+// Branding customizes the look of every email.
+type Branding struct {
+	AppName         string
+	LogoURL         string
+	PrimaryColor    string
+	BackgroundColor string
+}
+
+// Default branding values.
+const (
+	defaultAppName         = "Venio"
+	defaultPrimaryColor    = "#6d28d9"
+	defaultBackgroundColor = "#f3f4f6"
+)
+
+// hexColor matches CSS hex colors, the only color format accepted in
+// branding so values can be embedded in style attributes safely.
+var hexColor = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// withDefaults fills in missing or invalid branding values.
+func (b Branding) withDefaults() Branding {
+	if b.AppName == "" {
+		b.AppName = defaultAppName
+	}
+	if !hexColor.MatchString(b.PrimaryColor) {
+		b.PrimaryColor = defaultPrimaryColor
+	}
+	if !hexColor.MatchString(b.BackgroundColor) {
+		b.BackgroundColor = defaultBackgroundColor
+	}
+	return b
+}
+
+// templateVars is the root value every template is executed with. L
+// translates text into the recipient's locale, e.g.
+// {{.L.T "email.greeting" "name" .Data.Name}}.
+type templateVars struct {
+	Brand   Branding
+	L       *i18n.Localizer
+	Subject string
+	Data    any
+}
+
+// buttonVars is the value passed to the "button" partial.
+type buttonVars struct {
+	Brand Branding
+	L     *i18n.Localizer
+	Label string
+	URL   string
+}
+
+// funcs are available in every template.
+var funcs = map[string]any{
+	"button": func(vars templateVars, label, url string) buttonVars {
+		return buttonVars{Brand: vars.Brand, L: vars.L, Label: label, URL: url}
+	},
+}
+
+// compiledTemplate is one parsed email.
+type compiledTemplate struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+// Renderer turns templates and data into messages.
+type Renderer struct {
+	brand     Branding
+	bundle    *i18n.Bundle
+	templates map[string]compiledTemplate
+}
+
+// NewRenderer parses the email templates. Files in overrideDir replace the
+// built-in file with the same relative path (e.g. "layout.html" or
+// "partials/footer.html"), so operators can customize emails without
+// rebuilding. An empty overrideDir uses the built-in templates only. Text
+// is translated with the catalogs in bundle.
+func NewRenderer(brand Branding, bundle *i18n.Bundle, overrideDir string) (*Renderer, error) {
+	base, err := fs.Sub(builtinTemplates, "templates")
+	if err != nil {
+		return nil, err
+	}
+
+	files := base
+	if overrideDir != "" {
+		info, err := os.Stat(overrideDir)
+		if err != nil {
+			return nil, fmt.Errorf("invalid email template directory: %w", err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("email template directory %q is not a directory", overrideDir)
+		}
+		files = overlayFS{override: os.DirFS(overrideDir), base: base}
+		log.Printf("Loading email template overrides from %s", overrideDir)
+	}
+
+	textPartials, err := fs.Glob(base, "partials/*.txt")
+	if err != nil {
+		return nil, err
+	}
+	htmlPartials, err := fs.Glob(base, "partials/*.html")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Renderer{brand: brand.withDefaults(), bundle: bundle, templates: make(map[string]compiledTemplate, len(templateNames))}
+	for _, name := range templateNames {
+		subject, err := parseText(files, name+".subject.txt")
+		if err != nil {
+			return nil, err
+		}
+		text, err := parseText(files, append([]string{"layout.txt", name + ".txt"}, textPartials...)...)
+		if err != nil {
+			return nil, err
+		}
+		html, err := parseHTML(files, append([]string{"layout.html", name + ".html"}, htmlPartials...)...)
+		if err != nil {
+			return nil, err
+		}
+		r.templates[name] = compiledTemplate{subject: subject, text: text, html: html}
+	}
+
+	return r, nil
+}
+
+// Render builds a message for recipient to from the named template in the
+// given locale. An empty or unsupported locale falls back to the default
+// locale.
+func (r *Renderer) Render(name, locale, to string, data any) (*Message, error) {
+	tmpl, ok := r.templates[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownTemplate, name)
+	}
+
+	vars := templateVars{Brand: r.brand, L: r.bundle.Localizer(locale), Data: data}
+
+	var subject bytes.Buffer
+	if err := tmpl.subject.Execute(&subject, vars); err != nil {
+		return nil, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	// Subjects are single-line headers.
+	vars.Subject = strings.Join(strings.Fields(subject.String()), " ")
+
+	var text, html bytes.Buffer
+	if err := tmpl.text.Execute(&text, vars); err != nil {
+		return nil, fmt.Errorf("failed to render %s text: %w", name, err)
+	}
+	if err := tmpl.html.Execute(&html, vars); err != nil {
+		return nil, fmt.Errorf("failed to render %s HTML: %w", name, err)
+	}
+
+	return &Message{
+		To:      to,
+		Subject: vars.Subject,
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
+
+// parseText parses files into one text template named after the first file.
+func parseText(files fs.FS, names ...string) (*texttemplate.Template, error) {
+	var tmpl *texttemplate.Template
+	for _, name := range names {
+		src, err := fs.ReadFile(files, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read email template: %w", err)
+		}
+		if tmpl == nil {
+			tmpl = texttemplate.New(name).Funcs(funcs)
+		} else {
+			tmpl = tmpl.New(name)
+		}
+		if _, err := tmpl.Parse(string(src)); err != nil {
+			return nil, fmt.Errorf("failed to parse email template %s: %w", name, err)
+		}
+	}
+	return tmpl.Lookup(names[0]), nil
+}
+
+// parseHTML parses files into one HTML template named after the first file.
+func parseHTML(files fs.FS, names ...string) (*htmltemplate.Template, error) {
+	var tmpl *htmltemplate.Template
+	for _, name := range names {
+		src, err := fs.ReadFile(files, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read email template: %w", err)
+		}
+		if tmpl == nil {
+			tmpl = htmltemplate.New(name).Funcs(funcs)
+		} else {
+			tmpl = tmpl.New(name)
+		}
+		if _, err := tmpl.Parse(string(src)); err != nil {
+			return nil, fmt.Errorf("failed to parse email template %s: %w", name, err)
+		}
+	}
+	return tmpl.Lookup(names[0]), nil
+}
+
+// overlayFS serves files from override when present and from base otherwise.
+type overlayFS struct {
+	override fs.FS
+	base     fs.FS
+}
+
+// Open implements fs.FS.
+func (o overlayFS) Open(name string) (fs.File, error) {
+	f, err := o.override.Open(name)
+	if err == nil {
+		return f, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return o.base.Open(name)
+}
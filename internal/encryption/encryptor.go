@@ -0,0 +1,82 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package encryption provides authenticated encryption for secrets stored
+// in the database, such as third-party API keys.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// MinSecretLength is the minimum accepted length of the encryption secret.
+const MinSecretLength = 32
+
+// ErrInvalidCiphertext is returned when a value cannot be decrypted.
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+// Encryptor encrypts and decrypts strings with AES-256-GCM.
+type Encryptor struct {
+	aead cipher.AEAD
+}
+
+// NewEncryptor derives an AES-256 key from secret and returns an encryptor.
+func NewEncryptor(secret string) (*Encryptor, error) {
+	if len(secret) < MinSecretLength {
+		return nil, fmt.Errorf("encryption secret must be at least %d characters", MinSecretLength)
+	}
+
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	return &Encryptor{aead: aead}, nil
+}
+
+// Encrypt returns the base64-encoded nonce and ciphertext of plaintext.
+func (e *Encryptor) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := e.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt reverses Encrypt.
+func (e *Encryptor) Decrypt(encoded string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+
+	nonceSize := e.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", ErrInvalidCiphertext
+	}
+
+	plaintext, err := e.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+
+	return string(plaintext), nil
+}
@@ -0,0 +1,241 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package errortracking
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Sending of Client: up to bufferSize events wait to be sent, and sending
+// one may take sendTimeout.
+const (
+	bufferSize  = 100
+	sendTimeout = 10 * time.Second
+)
+
+// clientName identifies Venio to the service.
+const clientName = "venio"
+
+// Options describe where events come from.
+type Options struct {
+	// Environment is e.g. "production".
+	Environment string
+	// Release is the version that is running, e.g. "venio@0.1.0".
+	Release string
+	// ServerName is the host that reported an event.
+	ServerName string
+}
+
+// Client sends events to the project of a DSN through the envelope
+// endpoint of the Sentry protocol, in the background. Events that do not
+// fit into the buffer are dropped and logged, and so are events the
+// service refuses.
+type Client struct {
+	dsn    *DSN
+	opts   Options
+	http   *http.Client
+	events chan []byte
+	done   chan struct{}
+
+	// mu guards closed, so that Report never sends on the closed channel.
+	mu     sync.RWMutex
+	closed bool
+}
+
+// New starts a client for the project of dsn.
+func New(dsn string, opts Options) (*Client, error) {
+	parsed, err := ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		dsn:    parsed,
+		opts:   opts,
+		http:   &http.Client{Timeout: sendTimeout},
+		events: make(chan []byte, bufferSize),
+		done:   make(chan struct{}),
+	}
+	go c.run()
+	return c, nil
+}
+
+// Host returns the server events are sent to.
+func (c *Client) Host() string {
+	return c.dsn.Host
+}
+
+// Report implements Reporter. Events reported after Close are dropped;
+// whoever reports them has logged them already.
+func (c *Client) Report(_ context.Context, e *Event) {
+	body, id, err := c.envelope(e, time.Now())
+	if err != nil {
+		log.Printf("Failed to encode error report: %v", err)
+		return
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.closed {
+		return
+	}
+	select {
+	case c.events <- body:
+	default:
+		log.Printf("Error report buffer full, dropped event %s", id)
+	}
+}
+
+// Close sends the buffered events and stops. It may be called more than
+// once.
+func (c *Client) Close() {
+	c.mu.Lock()
+	if !c.closed {
+		c.closed = true
+		close(c.events)
+	}
+	c.mu.Unlock()
+	<-c.done
+}
+
+// run sends events until the client is closed.
+func (c *Client) run() {
+	defer close(c.done)
+	for body := range c.events {
+		if err := c.send(body); err != nil {
+			log.Printf("Failed to report error to %s: %v", c.dsn.Host, err)
+		}
+	}
+}
+
+// send posts an envelope.
+func (c *Client) send(body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.dsn.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf("Sentry sentry_version=7, sentry_client=%s/%s, sentry_key=%s",
+		clientName, c.opts.Release, c.dsn.publicKey))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// payload is an event in the Sentry protocol.
+type payload struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   time.Time         `json:"timestamp"`
+	Platform    string            `json:"platform"`
+	Level       string            `json:"level"`
+	Release     string            `json:"release,omitempty"`
+	Environment string            `json:"environment,omitempty"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	User        *User             `json:"user,omitempty"`
+	Request     *Request          `json:"request,omitempty"`
+	Exception   exceptions        `json:"exception"`
+}
+
+type exceptions struct {
+	Values []exception `json:"values"`
+}
+
+type exception struct {
+	Type       string      `json:"type"`
+	Value      string      `json:"value"`
+	Stacktrace *stacktrace `json:"stacktrace,omitempty"`
+}
+
+type stacktrace struct {
+	Frames []Frame `json:"frames"`
+}
+
+// envelope encodes e as an envelope holding a single event, and returns
+// it with the event's ID.
+func (c *Client) envelope(e *Event, now time.Time) ([]byte, string, error) {
+	id, err := eventID()
+	if err != nil {
+		return nil, "", err
+	}
+
+	p := payload{
+		EventID:     id,
+		Timestamp:   now.UTC(),
+		Platform:    "go",
+		Level:       e.Level,
+		Release:     c.opts.Release,
+		Environment: c.opts.Environment,
+		ServerName:  c.opts.ServerName,
+		Tags:        e.Tags,
+		User:        e.User,
+		Request:     e.Request,
+	}
+	if p.Level == "" {
+		p.Level = LevelError
+	}
+	ex := exception{Type: "error", Value: "unknown error"}
+	if e.Err != nil {
+		ex.Type, ex.Value = fmt.Sprintf("%T", e.Err), e.Err.Error()
+	}
+	if len(e.Stack) > 0 {
+		ex.Stacktrace = &stacktrace{Frames: e.Stack}
+	}
+	p.Exception.Values = []exception{ex}
+
+	event, err := json.Marshal(p)
+	if err != nil {
+		return nil, "", err
+	}
+	header, err := json.Marshal(map[string]any{"event_id": id, "sent_at": now.UTC()})
+	if err != nil {
+		return nil, "", err
+	}
+	item, err := json.Marshal(map[string]any{"type": "event", "length": len(event)})
+	if err != nil {
+		return nil, "", err
+	}
+
+	var b bytes.Buffer
+	for _, line := range [][]byte{header, item, event} {
+		b.Write(line)
+		b.WriteByte('\n')
+	}
+	return b.Bytes(), id, nil
+}
+
+// eventID returns a random event ID: 32 hex digits.
+func eventID() (string, error) {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id[:]), nil
+}
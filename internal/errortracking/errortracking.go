@@ -0,0 +1,157 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package errortracking reports panics and failures to Sentry or a
+// compatible service such as GlitchTip.
+package errortracking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"runtime"
+	"strings"
+)
+
+// Event levels.
+const (
+	LevelError = "error"
+	// LevelFatal marks panics.
+	LevelFatal = "fatal"
+)
+
+// modulePrefix identifies Venio's own functions in stack traces.
+const modulePrefix = "github.com/lusoris/venio/"
+
+// ErrInvalidDSN is returned for a DSN that does not name a project.
+var ErrInvalidDSN = errors.New("invalid error tracking DSN")
+
+// Event is a failure to report.
+type Event struct {
+	Err error
+	// Level is LevelError if empty.
+	Level string
+	// Tags index the event, e.g. by route or task type.
+	Tags map[string]string
+	// User and Request describe the API request that failed, if any.
+	User    *User
+	Request *Request
+	// Stack is where a panic happened; other failures have none.
+	Stack []Frame
+}
+
+// User identifies who made a request. No request has a user account yet,
+// so ID is the actor of the audit log, e.g. "api_key".
+type User struct {
+	ID        string `json:"id,omitempty"`
+	IPAddress string `json:"ip_address,omitempty"`
+}
+
+// Request describes an API request. URL is only the path, so query
+// parameters, which may hold secrets, are not sent.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+// Frame is one call of a stack trace.
+type Frame struct {
+	Function string `json:"function"`
+	Module   string `json:"module,omitempty"`
+	Filename string `json:"filename"`
+	AbsPath  string `json:"abs_path"`
+	Line     int    `json:"lineno"`
+	InApp    bool   `json:"in_app"`
+}
+
+// Stack returns the stack of the calling goroutine, oldest call first as
+// Sentry expects, leaving out skip calls above the caller.
+func Stack(skip int) []Frame {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip+2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var stack []Frame
+	for {
+		frame, more := frames.Next()
+		module, function := splitFunction(frame.Function)
+		stack = append(stack, Frame{
+			Function: function,
+			Module:   module,
+			Filename: path.Base(frame.File),
+			AbsPath:  frame.File,
+			Line:     frame.Line,
+			InApp:    strings.HasPrefix(frame.Function, modulePrefix),
+		})
+		if !more {
+			break
+		}
+	}
+	for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
+		stack[i], stack[j] = stack[j], stack[i]
+	}
+	return stack
+}
+
+// splitFunction splits a qualified function name such as
+// "github.com/lusoris/venio/internal/api.NewRouter.func1" into its package
+// and the function within it.
+func splitFunction(name string) (module, function string) {
+	slash := strings.LastIndex(name, "/")
+	dot := strings.Index(name[slash+1:], ".")
+	if dot < 0 {
+		return "", name
+	}
+	return name[:slash+1+dot], name[slash+2+dot:]
+}
+
+// Reporter reports failures. Reports are sent in the background, so
+// Report never blocks.
+type Reporter interface {
+	Report(ctx context.Context, event *Event)
+}
+
+// Discard is a Reporter that drops every event, used when error tracking
+// is disabled.
+var Discard Reporter = discard{}
+
+type discard struct{}
+
+func (discard) Report(context.Context, *Event) {}
+
+// DSN is the client key of a project, as shown in its settings:
+// https://<public key>@<host>[/<path>]/<project ID>.
+type DSN struct {
+	// Host is the server events are sent to.
+	Host string
+
+	endpoint  string
+	publicKey string
+}
+
+// ParseDSN parses a DSN.
+func ParseDSN(raw string) (*DSN, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDSN, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("%w: want an http or https URL", ErrInvalidDSN)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return nil, fmt.Errorf("%w: missing public key", ErrInvalidDSN)
+	}
+	prefix, project := path.Split(strings.TrimSuffix(u.Path, "/"))
+	if project == "" {
+		return nil, fmt.Errorf("%w: missing project ID", ErrInvalidDSN)
+	}
+
+	endpoint := url.URL{Scheme: u.Scheme, Host: u.Host, Path: prefix + "api/" + project + "/envelope/"}
+	return &DSN{Host: u.Host, endpoint: endpoint.String(), publicKey: u.User.Username()}, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package events defines domain events and dispatches them to subscribers.
+// Events are persisted through the transactional outbox and delivered by
+// the outbox relay, so subscribers see every committed state change.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Event types.
+const (
+	InstanceCreated = "instance.created"
+	InstanceUpdated = "instance.updated"
+	InstanceDeleted = "instance.deleted"
+)
+
+// Aggregate types.
+const (
+	AggregateInstance = "instance"
+)
+
+// Event is a domain event recorded in the outbox.
+type Event struct {
+	ID            int64           `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// New builds an event with a JSON-encoded payload.
+func New(eventType, aggregateType, aggregateID string, payload any) (*Event, error) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s payload: %w", eventType, err)
+	}
+
+	return &Event{
+		Type:          eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       encoded,
+	}, nil
+}
+
+// Handler processes a single event. If any handler fails, the event is
+// redelivered to the handlers that have not processed it yet, so each
+// handler sees an event once it succeeded.
+type Handler func(ctx context.Context, event *Event) error
+
+// subscription is a handler with the name its deliveries are recorded
+// under.
+type subscription struct {
+	name    string
+	handler Handler
+}
+
+// Dispatcher fans events out to the handlers subscribed to their type.
+type Dispatcher struct {
+	mu       sync.RWMutex
+	handlers map[string][]subscription
+	wildcard []subscription
+}
+
+// NewDispatcher creates an empty dispatcher.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{handlers: make(map[string][]subscription)}
+}
+
+// Subscribe registers handler for one event type under name, which must be
+// unique and stay the same across restarts.
+func (d *Dispatcher) Subscribe(eventType, name string, handler Handler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.handlers[eventType] = append(d.handlers[eventType], subscription{name, handler})
+}
+
+// SubscribeAll registers handler for every event type under name, which
+// must be unique and stay the same across restarts.
+func (d *Dispatcher) SubscribeAll(name string, handler Handler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.wildcard = append(d.wildcard, subscription{name, handler})
+}
+
+// Dispatch delivers event to the matching handlers whose names are not in
+// delivered. It returns the names of the handlers that succeeded, to be
+// passed in delivered when the event is dispatched again, and the combined
+// errors of the others.
+func (d *Dispatcher) Dispatch(ctx context.Context, event *Event, delivered []string) ([]string, error) {
+	d.mu.RLock()
+	subs := append(append([]subscription(nil), d.handlers[event.Type]...), d.wildcard...)
+	d.mu.RUnlock()
+
+	var (
+		succeeded []string
+		errs      []error
+	)
+	for _, sub := range subs {
+		if slices.Contains(delivered, sub.name) {
+			continue
+		}
+		if err := sub.handler(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sub.name, err))
+			continue
+		}
+		succeeded = append(succeeded, sub.name)
+	}
+	return succeeded, errors.Join(errs...)
+}
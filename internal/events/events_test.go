@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package events
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDispatch(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	var called []string
+	handler := func(name string, err error) Handler {
+		return func(context.Context, *Event) error {
+			called = append(called, name)
+			return err
+		}
+	}
+
+	d := NewDispatcher()
+	d.Subscribe(InstanceCreated, "created", handler("created", nil))
+	d.Subscribe(InstanceDeleted, "deleted", handler("deleted", nil))
+	d.SubscribeAll("all", handler("all", nil))
+	d.SubscribeAll("failing", handler("failing", errFailed))
+
+	tests := []struct {
+		name          string
+		eventType     string
+		delivered     []string
+		wantCalled    []string
+		wantSucceeded []string
+		wantErr       bool
+	}{
+		{
+			name:          "first delivery",
+			eventType:     InstanceCreated,
+			wantCalled:    []string{"created", "all", "failing"},
+			wantSucceeded: []string{"created", "all"},
+			wantErr:       true,
+		},
+		{
+			name:          "redelivery skips handlers that succeeded",
+			eventType:     InstanceCreated,
+			delivered:     []string{"created", "all"},
+			wantCalled:    []string{"failing"},
+			wantSucceeded: nil,
+			wantErr:       true,
+		},
+		{
+			name:          "all delivered",
+			eventType:     InstanceUpdated,
+			delivered:     []string{"all", "failing"},
+			wantCalled:    nil,
+			wantSucceeded: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = nil
+			succeeded, err := d.Dispatch(context.Background(), &Event{Type: tt.eventType}, tt.delivered)
+
+			if !slices.Equal(called, tt.wantCalled) {
+				t.Errorf("called %v, want %v", called, tt.wantCalled)
+			}
+			if !slices.Equal(succeeded, tt.wantSucceeded) {
+				t.Errorf("Dispatch() succeeded = %v, want %v", succeeded, tt.wantSucceeded)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Dispatch() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && (!errors.Is(err, errFailed) || !strings.HasPrefix(err.Error(), "failing: ")) {
+				t.Errorf("Dispatch() error = %v, want the failing handler's error", err)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	event, err := New(InstanceCreated, AggregateInstance, "7", map[string]string{"name": "radarr"})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if event.Type != InstanceCreated || event.AggregateType != AggregateInstance || event.AggregateID != "7" {
+		t.Errorf("New() = %+v", event)
+	}
+	if got := string(event.Payload); got != `{"name":"radarr"}` {
+		t.Errorf("New() payload = %s", got)
+	}
+}
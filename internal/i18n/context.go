@@ -0,0 +1,68 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// contextKey is the context key under which the localizer is stored.
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying l.
+func NewContext(ctx context.Context, l *Localizer) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the localizer stored in ctx. Without one it returns a
+// localizer without catalogs, which returns keys as they are.
+func FromContext(ctx context.Context) *Localizer {
+	if l, ok := ctx.Value(contextKey{}).(*Localizer); ok {
+		return l
+	}
+	return &Localizer{locale: FallbackLocale}
+}
+
+// Negotiate picks the supported locale the client prefers most from an
+// Accept-Language header such as "de-AT,de;q=0.9,en;q=0.8". It returns ""
+// when no listed language is supported, which Localizer treats as the
+// default locale.
+func (b *Bundle) Negotiate(acceptLanguage string) string {
+	type preference struct {
+		locale string
+		q      float64
+	}
+
+	var preferences []preference
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		locale, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if locale == "" || locale == "*" || q <= 0 {
+			continue
+		}
+		preferences = append(preferences, preference{locale: locale, q: q})
+	}
+
+	sort.SliceStable(preferences, func(i, j int) bool { return preferences[i].q > preferences[j].q })
+	for _, p := range preferences {
+		if b.Supports(p.locale) {
+			return normalize(p.locale)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package i18n translates user-facing text such as emails, notifications,
+// and API errors using embedded message catalogs.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// FallbackLocale is the last locale in every fallback chain. Its catalog
+// must contain every key.
+const FallbackLocale = "en"
+
+// catalogFiles holds one JSON catalog per locale, named "<locale>.json".
+//
+//go:embed locales/*.json
+var catalogFiles embed.FS
+
+// message is a catalog entry: either a plain text or plural forms keyed by
+// category ("zero", "one", "other").
+type message struct {
+	text   string
+	plural map[string]string
+}
+
+// UnmarshalJSON accepts a string or an object of plural forms.
+func (m *message) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &m.text); err == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, &m.plural); err != nil {
+		return fmt.Errorf("message must be a string or an object of plural forms")
+	}
+	if _, ok := m.plural["other"]; !ok {
+		return fmt.Errorf("plural message needs an \"other\" form")
+	}
+	return nil
+}
+
+// Bundle holds the catalogs of all supported locales.
+type Bundle struct {
+	catalogs      map[string]map[string]message
+	defaultLocale string
+}
+
+// NewBundle loads the embedded catalogs. defaultLocale is used when a
+// requested locale has no catalog and must itself be supported.
+func NewBundle(defaultLocale string) (*Bundle, error) {
+	files, err := fs.Glob(catalogFiles, "locales/*.json")
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Bundle{catalogs: make(map[string]map[string]message, len(files))}
+	for _, file := range files {
+		data, err := catalogFiles.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var catalog map[string]message
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return nil, fmt.Errorf("invalid message catalog %s: %w", file, err)
+		}
+		b.catalogs[normalize(strings.TrimSuffix(path.Base(file), ".json"))] = catalog
+	}
+
+	if _, ok := b.catalogs[FallbackLocale]; !ok {
+		return nil, fmt.Errorf("message catalog for %q is missing", FallbackLocale)
+	}
+
+	b.defaultLocale = normalize(defaultLocale)
+	if b.defaultLocale == "" {
+		b.defaultLocale = FallbackLocale
+	}
+	if _, ok := b.catalogs[b.defaultLocale]; !ok {
+		return nil, fmt.Errorf("unsupported default locale %q (supported: %s)",
+			defaultLocale, strings.Join(b.Locales(), ", "))
+	}
+
+	return b, nil
+}
+
+// Locales returns the supported locales in alphabetical order.
+func (b *Bundle) Locales() []string {
+	locales := make([]string, 0, len(b.catalogs))
+	for locale := range b.catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Supports reports whether locale or its base language has a catalog.
+func (b *Bundle) Supports(locale string) bool {
+	for _, candidate := range candidates(normalize(locale)) {
+		if _, ok := b.catalogs[candidate]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Localizer returns a localizer for locale. Messages are looked up in the
+// locale, its base language, the default locale, and FallbackLocale, in
+// that order; an empty locale starts at the default locale.
+func (b *Bundle) Localizer(locale string) *Localizer {
+	var chain []string
+	seen := make(map[string]bool)
+	add := func(locale string) {
+		if _, ok := b.catalogs[locale]; ok && !seen[locale] {
+			seen[locale] = true
+			chain = append(chain, locale)
+		}
+	}
+
+	for _, candidate := range candidates(normalize(locale)) {
+		add(candidate)
+	}
+	add(b.defaultLocale)
+	add(FallbackLocale)
+
+	catalogs := make([]map[string]message, len(chain))
+	for i, locale := range chain {
+		catalogs[i] = b.catalogs[locale]
+	}
+	return &Localizer{locale: chain[0], catalogs: catalogs}
+}
+
+// normalize turns a locale such as "de_AT" or "DE-at" into "de-at".
+func normalize(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// candidates returns locale followed by its shorter prefixes, e.g.
+// "pt-br" and "pt".
+func candidates(locale string) []string {
+	var result []string
+	for locale != "" {
+		result = append(result, locale)
+		i := strings.LastIndexByte(locale, '-')
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	return result
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package i18n
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Localizer translates messages into one locale. Its methods are meant to
+// be called from templates as well as from Go code.
+type Localizer struct {
+	locale   string
+	catalogs []map[string]message
+}
+
+// Locale returns the locale messages are looked up in first.
+func (l *Localizer) Locale() string {
+	return l.locale
+}
+
+// T returns the message for key with its placeholders filled in. args are
+// name/value pairs: T("greeting", "name", "Ada") replaces "{name}". A
+// missing key is logged and returned as is.
+func (l *Localizer) T(key string, args ...any) string {
+	msg, ok := l.lookup(key)
+	if !ok {
+		return key
+	}
+	text := msg.text
+	if msg.plural != nil {
+		text = msg.plural["other"]
+	}
+	return format(text, args)
+}
+
+// N returns the plural form of key that matches count, with "{count}" and
+// the placeholders in args filled in. count may be any integer or float
+// type.
+func (l *Localizer) N(key string, count any, args ...any) string {
+	msg, ok := l.lookup(key)
+	if !ok {
+		return key
+	}
+
+	n := toInt64(count)
+	text := msg.text
+	if msg.plural != nil {
+		text = msg.plural["other"]
+		if form, ok := msg.plural[pluralCategory(l.locale, n)]; ok {
+			text = form
+		}
+		if form, ok := msg.plural["zero"]; ok && n == 0 {
+			text = form
+		}
+	}
+	return format(text, append([]any{"count", n}, args...))
+}
+
+// Has reports whether key has a message in any catalog of the fallback
+// chain.
+func (l *Localizer) Has(key string) bool {
+	for _, catalog := range l.catalogs {
+		if _, ok := catalog[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Date formats t as a date in the locale's style.
+func (l *Localizer) Date(t time.Time) string {
+	return t.Format(l.T("format.date"))
+}
+
+// DateTime formats t as a date and time in the locale's style.
+func (l *Localizer) DateTime(t time.Time) string {
+	return t.Format(l.T("format.datetime"))
+}
+
+// Duration formats d in the largest whole unit, e.g. "2 hours" or
+// "30 minutes". d may be a time.Duration or a number of nanoseconds, which
+// is how durations arrive after a JSON round trip.
+func (l *Localizer) Duration(d any) string {
+	duration := time.Duration(toInt64(d))
+	switch {
+	case duration >= 24*time.Hour && duration%(24*time.Hour) == 0:
+		return l.N("duration.days", int64(duration/(24*time.Hour)))
+	case duration >= time.Hour && duration%time.Hour == 0:
+		return l.N("duration.hours", int64(duration/time.Hour))
+	default:
+		return l.N("duration.minutes", int64(math.Ceil(duration.Minutes())))
+	}
+}
+
+// lookup walks the fallback chain for key.
+func (l *Localizer) lookup(key string) (message, bool) {
+	for _, catalog := range l.catalogs {
+		if msg, ok := catalog[key]; ok {
+			return msg, true
+		}
+	}
+	log.Printf("Missing translation for %q (%s)", key, l.locale)
+	return message{}, false
+}
+
+// format replaces "{name}" placeholders with the values in args.
+func format(text string, args []any) string {
+	if len(args) == 0 || !strings.Contains(text, "{") {
+		return text
+	}
+
+	pairs := make([]string, 0, len(args))
+	for i := 0; i+1 < len(args); i += 2 {
+		pairs = append(pairs, "{"+fmt.Sprint(args[i])+"}", fmt.Sprint(args[i+1]))
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
+
+// pluralCategory returns the CLDR plural category of n for the locale's
+// language. Only the rules of languages with a catalog need to be listed.
+func pluralCategory(locale string, n int64) string {
+	language, _, _ := strings.Cut(locale, "-")
+	switch language {
+	case "fr", "pt":
+		if n == 0 || n == 1 {
+			return "one"
+		}
+	default:
+		if n == 1 {
+			return "one"
+		}
+	}
+	return "other"
+}
+
+// toInt64 converts the numeric types templates may pass to an int64.
+func toInt64(v any) int64 {
+	switch n := v.(type) {
+	case int:
+		return int64(n)
+	case int32:
+		return int64(n)
+	case int64:
+		return n
+	case uint:
+		return int64(n)
+	case float32:
+		return int64(n)
+	case float64:
+		return int64(n)
+	case time.Duration:
+		return int64(n)
+	case string:
+		parsed, _ := strconv.ParseInt(n, 10, 64)
+		return parsed
+	default:
+		return 0
+	}
+}
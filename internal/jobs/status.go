@@ -0,0 +1,168 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package jobs tracks the status of long-running operations started through
+// the API and executed by the worker.
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix namespaces job status keys in Redis.
+const keyPrefix = "venio:job:"
+
+// ErrNotFound is returned when a job does not exist or has expired.
+var ErrNotFound = errors.New("job not found")
+
+// State is the lifecycle state of a job.
+type State string
+
+// Job states.
+const (
+	StateQueued    State = "queued"
+	StateRunning   State = "running"
+	StateCompleted State = "completed"
+	StateFailed    State = "failed"
+)
+
+// Status is the externally visible state of a job.
+type Status struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	State     State           `json:"state"`
+	Progress  int             `json:"progress"`
+	Message   string          `json:"message,omitempty"`
+	Result    json.RawMessage `json:"result,omitempty"`
+	Error     string          `json:"error,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// Store keeps job statuses in Redis. Every write refreshes the TTL, so a
+// status disappears once the job has been idle for that long.
+type Store struct {
+	client redis.UniversalClient
+	ttl    time.Duration
+}
+
+// NewStore creates a job status store.
+func NewStore(client redis.UniversalClient, ttl time.Duration) *Store {
+	return &Store{client: client, ttl: ttl}
+}
+
+// Create registers a new queued job of the given type.
+func (s *Store) Create(ctx context.Context, jobType string) (*Status, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	status := &Status{ID: id, Type: jobType, State: StateQueued, CreatedAt: now, UpdatedAt: now}
+	if err := s.save(ctx, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Get returns the status of a job.
+func (s *Store) Get(ctx context.Context, id string) (*Status, error) {
+	raw, err := s.client.Get(ctx, keyPrefix+id).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get job status: %w", err)
+	}
+
+	var status Status
+	if err := json.Unmarshal(raw, &status); err != nil {
+		return nil, fmt.Errorf("failed to decode job status: %w", err)
+	}
+	return &status, nil
+}
+
+// Start marks a job as running.
+func (s *Store) Start(ctx context.Context, id string) error {
+	return s.update(ctx, id, func(status *Status) {
+		status.State = StateRunning
+	})
+}
+
+// Progress records the completion percentage and an optional message.
+func (s *Store) Progress(ctx context.Context, id string, percent int, message string) error {
+	return s.update(ctx, id, func(status *Status) {
+		status.Progress = min(max(percent, 0), 100)
+		status.Message = message
+	})
+}
+
+// Complete marks a job as completed with a JSON-encodable result.
+func (s *Store) Complete(ctx context.Context, id string, result any) error {
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode job result: %w", err)
+	}
+
+	return s.update(ctx, id, func(status *Status) {
+		status.State = StateCompleted
+		status.Progress = 100
+		status.Result = encoded
+	})
+}
+
+// Fail marks a job as failed.
+func (s *Store) Fail(ctx context.Context, id string, jobErr error) error {
+	return s.update(ctx, id, func(status *Status) {
+		status.State = StateFailed
+		status.Error = jobErr.Error()
+	})
+}
+
+// update applies fn to a stored status and saves it. Each job is written by
+// a single worker at a time, so no locking is needed.
+func (s *Store) update(ctx context.Context, id string, fn func(*Status)) error {
+	status, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	fn(status)
+	status.UpdatedAt = time.Now().UTC()
+	return s.save(ctx, status)
+}
+
+// save writes a status with the store's TTL.
+func (s *Store) save(ctx context.Context, status *Status) error {
+	encoded, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("failed to encode job status: %w", err)
+	}
+
+	if err := s.client.Set(ctx, keyPrefix+status.ID, encoded, s.ttl).Err(); err != nil {
+		return fmt.Errorf("failed to save job status: %w", err)
+	}
+	return nil
+}
+
+// newID returns a random, unguessable job ID.
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate job ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
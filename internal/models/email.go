@@ -0,0 +1,40 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package models
+
+import "time"
+
+// TestEmailRequest is the payload for sending a test email.
+type TestEmailRequest struct {
+	To     string `json:"to" binding:"required"`
+	Locale string `json:"locale"`
+}
+
+// EmailSuppressionReason says why an address no longer receives email.
+type EmailSuppressionReason string
+
+// Suppression reasons.
+const (
+	// EmailSuppressionBounce is a permanent bounce, e.g. an unknown mailbox.
+	EmailSuppressionBounce EmailSuppressionReason = "bounce"
+	// EmailSuppressionComplaint means the recipient marked an email as spam.
+	EmailSuppressionComplaint EmailSuppressionReason = "complaint"
+)
+
+// EmailSuppression is an address that is not sent email any more because
+// the provider reported it as undeliverable.
+type EmailSuppression struct {
+	ID     int64                  `json:"id"`
+	Email  string                 `json:"email"`
+	Reason EmailSuppressionReason `json:"reason"`
+	// Provider is the email provider that reported the address.
+	Provider  string    `json:"provider"`
+	Detail    string    `json:"detail,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
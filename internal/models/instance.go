@@ -0,0 +1,79 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package models
+
+import "time"
+
+// InstanceType identifies the kind of external service an instance points to.
+type InstanceType string
+
+// Supported instance types.
+const (
+	InstanceTypeRadarr   InstanceType = "radarr"
+	InstanceTypeSonarr   InstanceType = "sonarr"
+	InstanceTypePlex     InstanceType = "plex"
+	InstanceTypeJellyfin InstanceType = "jellyfin"
+)
+
+// Valid reports whether t is a known instance type.
+func (t InstanceType) Valid() bool {
+	switch t {
+	case InstanceTypeRadarr, InstanceTypeSonarr, InstanceTypePlex, InstanceTypeJellyfin:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsArr reports whether t is a Radarr/Sonarr-style instance.
+func (t InstanceType) IsArr() bool {
+	return t == InstanceTypeRadarr || t == InstanceTypeSonarr
+}
+
+// Instance is a configured Arr or media server instance. The API key is
+// decrypted in memory and never serialized.
+type Instance struct {
+	ID                      int64        `json:"id"`
+	Name                    string       `json:"name"`
+	Type                    InstanceType `json:"type"`
+	URL                     string       `json:"url"`
+	APIKey                  string       `json:"-"`
+	DefaultQualityProfileID *int         `json:"default_quality_profile_id,omitempty"`
+	DefaultRootFolder       string       `json:"default_root_folder,omitempty"`
+	Is4K                    bool         `json:"is_4k"`
+	IsDefault               bool         `json:"is_default"`
+	Enabled                 bool         `json:"enabled"`
+	CreatedAt               time.Time    `json:"created_at"`
+	UpdatedAt               time.Time    `json:"updated_at"`
+}
+
+// CreateInstanceRequest is the payload for creating an instance.
+type CreateInstanceRequest struct {
+	Name                    string       `json:"name" binding:"required,max=100"`
+	Type                    InstanceType `json:"type" binding:"required"`
+	URL                     string       `json:"url" binding:"required,url"`
+	APIKey                  string       `json:"api_key" binding:"required"`
+	DefaultQualityProfileID *int         `json:"default_quality_profile_id"`
+	DefaultRootFolder       string       `json:"default_root_folder"`
+	Is4K                    bool         `json:"is_4k"`
+	IsDefault               bool         `json:"is_default"`
+	Enabled                 *bool        `json:"enabled"`
+}
+
+// UpdateInstanceRequest is the payload for updating an instance. Omitted
+// fields are left unchanged.
+type UpdateInstanceRequest struct {
+	Name                    *string `json:"name" binding:"omitempty,max=100"`
+	URL                     *string `json:"url" binding:"omitempty,url"`
+	APIKey                  *string `json:"api_key"`
+	DefaultQualityProfileID *int    `json:"default_quality_profile_id"`
+	DefaultRootFolder       *string `json:"default_root_folder"`
+	Is4K                    *bool   `json:"is_4k"`
+	IsDefault               *bool   `json:"is_default"`
+	Enabled                 *bool   `json:"enabled"`
+}
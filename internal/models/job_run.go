@@ -0,0 +1,47 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package models
+
+import "time"
+
+// JobRunStatus is the outcome of a single task execution.
+type JobRunStatus string
+
+// Job run outcomes.
+const (
+	JobRunSucceeded JobRunStatus = "succeeded"
+	JobRunFailed    JobRunStatus = "failed"
+)
+
+// Valid reports whether s is a known job run status.
+func (s JobRunStatus) Valid() bool {
+	return s == JobRunSucceeded || s == JobRunFailed
+}
+
+// JobRun records one execution of a background task. Payloads are not
+// stored; PayloadDigest (hex SHA-256) tells runs with identical input apart.
+type JobRun struct {
+	ID            int64        `json:"id"`
+	TaskID        string       `json:"task_id,omitempty"`
+	Type          string       `json:"type"`
+	Queue         string       `json:"queue,omitempty"`
+	Status        JobRunStatus `json:"status"`
+	Attempt       int          `json:"attempt"`
+	DurationMS    int64        `json:"duration_ms"`
+	Error         string       `json:"error,omitempty"`
+	PayloadDigest string       `json:"payload_digest"`
+	StartedAt     time.Time    `json:"started_at"`
+	FinishedAt    time.Time    `json:"finished_at"`
+}
+
+// JobRunFilter narrows a job history query. Zero values match everything.
+type JobRunFilter struct {
+	Type   string
+	Status JobRunStatus
+	Since  time.Time
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package models contains data structures shared across Venio's layers.
+package models
+
+// MediaType identifies the kind of media a record refers to.
+type MediaType string
+
+// Supported media types.
+const (
+	MediaTypeMovie MediaType = "movie"
+	MediaTypeTV    MediaType = "tv"
+)
+
+// Valid reports whether t is a known media type.
+func (t MediaType) Valid() bool {
+	return t == MediaTypeMovie || t == MediaTypeTV
+}
+
+// MediaDetails is provider-agnostic metadata for a movie or TV show.
+type MediaDetails struct {
+	TMDBID       int64     `json:"tmdb_id"`
+	TVDBID       int64     `json:"tvdb_id,omitempty"`
+	IMDbID       string    `json:"imdb_id,omitempty"`
+	MediaType    MediaType `json:"media_type"`
+	Title        string    `json:"title"`
+	Overview     string    `json:"overview"`
+	ReleaseDate  string    `json:"release_date,omitempty"`
+	PosterPath   string    `json:"poster_path,omitempty"`
+	BackdropPath string    `json:"backdrop_path,omitempty"`
+	Genres       []string  `json:"genres,omitempty"`
+	Runtime      int       `json:"runtime,omitempty"`
+}
+
+// QualityProfile is a quality profile configured in a Radarr/Sonarr instance.
+type QualityProfile struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// GUIDMapping maps a Plex metadata GUID to external provider IDs.
+type GUIDMapping struct {
+	GUID   string `json:"guid"`
+	TMDBID int64  `json:"tmdb_id,omitempty"`
+	TVDBID int64  `json:"tvdb_id,omitempty"`
+	IMDbID string `json:"imdb_id,omitempty"`
+}
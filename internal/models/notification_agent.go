@@ -0,0 +1,59 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// NotificationAgent is a configured notification channel such as a Discord
+// webhook. Settings hold credentials and are never serialized.
+type NotificationAgent struct {
+	ID       int64             `json:"id"`
+	Name     string            `json:"name"`
+	Type     string            `json:"type"`
+	Settings map[string]string `json:"-"`
+	// Events limits notifications to these event types; empty means all.
+	Events []string `json:"events"`
+	// Locale is the language of the notification text; empty uses the
+	// default locale.
+	Locale    string    `json:"locale"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Subscribes reports whether the agent wants notifications for eventType.
+func (a *NotificationAgent) Subscribes(eventType string) bool {
+	return len(a.Events) == 0 || slices.Contains(a.Events, eventType)
+}
+
+// CreateNotificationAgentRequest is the payload for creating an agent. The
+// type and settings can be given as an Apprise URL instead.
+type CreateNotificationAgentRequest struct {
+	Name     string            `json:"name" binding:"required,max=100"`
+	Type     string            `json:"type" binding:"required_without=URL"`
+	Settings map[string]string `json:"settings" binding:"required_without=URL"`
+	URL      string            `json:"url"`
+	Events   []string          `json:"events"`
+	Locale   string            `json:"locale"`
+	Enabled  *bool             `json:"enabled"`
+}
+
+// UpdateNotificationAgentRequest is the payload for updating an agent.
+// Omitted fields are left unchanged; settings are replaced as a whole,
+// either directly or by an Apprise URL of the agent's type.
+type UpdateNotificationAgentRequest struct {
+	Name     *string            `json:"name" binding:"omitempty,max=100"`
+	Settings *map[string]string `json:"settings"`
+	URL      *string            `json:"url"`
+	Events   *[]string          `json:"events"`
+	Locale   *string            `json:"locale"`
+	Enabled  *bool              `json:"enabled"`
+}
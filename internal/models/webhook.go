@@ -0,0 +1,72 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package models
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// Webhook is an outgoing webhook endpoint. The signing secret is decrypted
+// in memory and never serialized.
+type Webhook struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Secret signs every delivery (see package webhooks).
+	Secret string `json:"-"`
+	// Events limits deliveries to these event types; empty means all.
+	Events              []string  `json:"events"`
+	Enabled             bool      `json:"enabled"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	DisabledReason      string    `json:"disabled_reason,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// Subscribes reports whether the webhook wants events of the given type.
+func (w *Webhook) Subscribes(eventType string) bool {
+	return len(w.Events) == 0 || slices.Contains(w.Events, eventType)
+}
+
+// WebhookDelivery records a single attempt to deliver an event to a webhook.
+type WebhookDelivery struct {
+	ID         int64  `json:"id"`
+	WebhookID  int64  `json:"webhook_id"`
+	EventID    int64  `json:"event_id"`
+	EventType  string `json:"event_type"`
+	Attempt    int    `json:"attempt"`
+	Success    bool   `json:"success"`
+	StatusCode *int   `json:"status_code,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+	// Payload is the request body; it is empty for deliveries recorded
+	// before payloads were kept.
+	Payload   json.RawMessage `json:"payload,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// CreateWebhookRequest is the payload for creating a webhook.
+type CreateWebhookRequest struct {
+	Name    string   `json:"name" binding:"required,max=100"`
+	URL     string   `json:"url" binding:"required,url"`
+	Secret  string   `json:"secret" binding:"required,min=16"`
+	Events  []string `json:"events"`
+	Enabled *bool    `json:"enabled"`
+}
+
+// UpdateWebhookRequest is the payload for updating a webhook. Omitted fields
+// are left unchanged. Re-enabling a webhook resets its failure counter.
+type UpdateWebhookRequest struct {
+	Name    *string   `json:"name" binding:"omitempty,max=100"`
+	URL     *string   `json:"url" binding:"omitempty,url"`
+	Secret  *string   `json:"secret" binding:"omitempty,min=16"`
+	Events  *[]string `json:"events"`
+	Enabled *bool     `json:"enabled"`
+}
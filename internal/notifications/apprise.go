@@ -0,0 +1,196 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package notifications
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// appriseURL is an Apprise notification URL split into its parts. Apprise
+// URLs are not always valid URLs (a Telegram bot token contains a colon
+// where a port would be), so they are split by hand.
+type appriseURL struct {
+	scheme   string
+	user     string
+	password string
+	host     string
+	path     []string
+	query    url.Values
+}
+
+// parseAppriseURL splits raw into scheme, credentials, host, path
+// segments, and query.
+func parseAppriseURL(raw string) (*appriseURL, error) {
+	scheme, rest, ok := strings.Cut(strings.TrimSpace(raw), "://")
+	if !ok || scheme == "" {
+		return nil, fmt.Errorf("%w: %q is not an Apprise URL", ErrInvalidSettings, raw)
+	}
+
+	u := &appriseURL{scheme: strings.ToLower(scheme)}
+
+	rest, rawQuery, _ := strings.Cut(rest, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid query: %v", ErrInvalidSettings, err)
+	}
+	u.query = query
+
+	if at := strings.LastIndex(rest, "@"); at >= 0 {
+		user, password, _ := strings.Cut(rest[:at], ":")
+		if u.user, err = url.PathUnescape(user); err != nil {
+			return nil, fmt.Errorf("%w: invalid user: %v", ErrInvalidSettings, err)
+		}
+		if u.password, err = url.PathUnescape(password); err != nil {
+			return nil, fmt.Errorf("%w: invalid password: %v", ErrInvalidSettings, err)
+		}
+		rest = rest[at+1:]
+	}
+
+	segments := strings.Split(rest, "/")
+	u.host = segments[0]
+	for _, segment := range segments[1:] {
+		if segment == "" {
+			continue
+		}
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid path: %v", ErrInvalidSettings, err)
+		}
+		u.path = append(u.path, unescaped)
+	}
+	if u.host == "" {
+		return nil, fmt.Errorf("%w: %s URL has no host", ErrInvalidSettings, u.scheme)
+	}
+	return u, nil
+}
+
+// ParseAppriseURL converts an Apprise notification URL, as used by other
+// self-hosted tools, into an agent type and settings. Supported schemes:
+//
+//	discord://[botname@]webhook_id/webhook_token
+//	slack://[botname@]token_a/token_b/token_c
+//	tgram://bot_token/chat_id
+//	pover://user_key@app_token[/device]
+//	gotify://host[:port][/path]/app_token and gotifys:// for HTTPS
+//	ntfy://[user:password@]host/topic, ntfys:// for HTTPS, and ntfy://topic
+//	for ntfy.sh
+//
+// Query options for the channel, such as avatar_url, sound, or priority,
+// are mapped where the agent supports them and ignored otherwise.
+func ParseAppriseURL(raw string) (agentType string, settings map[string]string, err error) {
+	u, err := parseAppriseURL(raw)
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch u.scheme {
+	case "discord":
+		if len(u.path) != 1 {
+			return "", nil, fmt.Errorf("%w: discord URLs have the form discord://webhook_id/webhook_token", ErrInvalidSettings)
+		}
+		settings = map[string]string{
+			"webhook_url": "https://discord.com/api/webhooks/" + u.host + "/" + u.path[0],
+		}
+		setIf(settings, "username", u.user)
+		setIf(settings, "avatar_url", u.query.Get("avatar_url"))
+		return TypeDiscord, settings, nil
+
+	case "slack":
+		// Channel overrides are only possible with bot tokens, which the
+		// Slack agent does not use.
+		if len(u.path) != 2 {
+			return "", nil, fmt.Errorf("%w: slack URLs have the form slack://token_a/token_b/token_c", ErrInvalidSettings)
+		}
+		return TypeSlack, map[string]string{
+			"webhook_url": "https://hooks.slack.com/services/" + u.host + "/" + u.path[0] + "/" + u.path[1],
+		}, nil
+
+	case "tgram":
+		if len(u.path) != 1 {
+			return "", nil, fmt.Errorf("%w: tgram URLs need exactly one chat ID", ErrInvalidSettings)
+		}
+		settings = map[string]string{"bot_token": u.host, "chat_id": u.path[0]}
+		setIf(settings, "message_thread_id", u.query.Get("topic"))
+		if silent := u.query.Get("silent"); silent != "" {
+			settings["silent"] = strconv.FormatBool(appriseBool(silent))
+		}
+		return TypeTelegram, settings, nil
+
+	case "pover":
+		if u.user == "" || len(u.path) > 1 {
+			return "", nil, fmt.Errorf("%w: pover URLs have the form pover://user_key@app_token[/device]", ErrInvalidSettings)
+		}
+		settings = map[string]string{"user_key": u.user, "app_token": u.host}
+		if len(u.path) == 1 {
+			settings["device"] = u.path[0]
+		}
+		setIf(settings, "sound", u.query.Get("sound"))
+		return TypePushover, settings, nil
+
+	case "gotify", "gotifys":
+		if len(u.path) == 0 {
+			return "", nil, fmt.Errorf("%w: gotify URLs need an app token", ErrInvalidSettings)
+		}
+		serverPath := strings.Join(u.path[:len(u.path)-1], "/")
+		return TypeGotify, map[string]string{
+			"server_url": strings.TrimSuffix(appriseHTTPScheme(u.scheme)+u.host+"/"+serverPath, "/"),
+			"app_token":  u.path[len(u.path)-1],
+		}, nil
+
+	case "ntfy", "ntfys":
+		settings = make(map[string]string)
+		switch len(u.path) {
+		case 0:
+			// ntfy://topic uses the public server.
+			settings["topic"] = u.host
+		case 1:
+			settings["server_url"] = appriseHTTPScheme(u.scheme) + u.host
+			settings["topic"] = u.path[0]
+		default:
+			return "", nil, fmt.Errorf("%w: ntfy URLs need exactly one topic", ErrInvalidSettings)
+		}
+		if token := u.query.Get("token"); token != "" {
+			settings["access_token"] = token
+		} else {
+			setIf(settings, "username", u.user)
+			setIf(settings, "password", u.password)
+		}
+		return TypeNtfy, settings, nil
+
+	default:
+		return "", nil, fmt.Errorf("%w: unsupported Apprise scheme %q", ErrInvalidSettings, u.scheme)
+	}
+}
+
+// appriseHTTPScheme returns the HTTP scheme for an Apprise scheme; a
+// trailing "s" selects HTTPS.
+func appriseHTTPScheme(scheme string) string {
+	if strings.HasSuffix(scheme, "s") {
+		return "https://"
+	}
+	return "http://"
+}
+
+// appriseBool parses Apprise's boolean query values ("yes", "true", "1").
+func appriseBool(value string) bool {
+	switch strings.ToLower(value) {
+	case "yes", "y", "true", "1", "on":
+		return true
+	}
+	return false
+}
+
+// setIf sets settings[key] to value unless value is empty.
+func setIf(settings map[string]string, key, value string) {
+	if value != "" {
+		settings[key] = value
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package notifications
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Discord embed limits.
+const (
+	discordTitleLimit       = 256
+	discordDescriptionLimit = 4096
+	discordFieldLimit       = 25
+	discordFieldValueLimit  = 1024
+)
+
+// discordColors maps levels to embed colors.
+var discordColors = map[Level]int{
+	LevelInfo:    0x5865f2,
+	LevelSuccess: 0x57f287,
+	LevelWarning: 0xfee75c,
+	LevelError:   0xed4245,
+}
+
+// DiscordAgent posts notifications as embeds to a Discord webhook.
+//
+// Settings: webhook_url (required), username, avatar_url.
+type DiscordAgent struct {
+	webhookURL string
+	username   string
+	avatarURL  string
+	client     *http.Client
+}
+
+// newDiscordAgent creates a Discord agent from its settings.
+func newDiscordAgent(settings map[string]string, client *http.Client) (*DiscordAgent, error) {
+	webhookURL, err := requireSetting(settings, "webhook_url")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiscordAgent{
+		webhookURL: webhookURL,
+		username:   settings["username"],
+		avatarURL:  settings["avatar_url"],
+		client:     client,
+	}, nil
+}
+
+// discordMessage is the body of a Discord webhook request.
+type discordMessage struct {
+	Username  string         `json:"username,omitempty"`
+	AvatarURL string         `json:"avatar_url,omitempty"`
+	Embeds    []discordEmbed `json:"embeds"`
+}
+
+// discordEmbed is a Discord message embed.
+type discordEmbed struct {
+	Title       string              `json:"title,omitempty"`
+	Description string              `json:"description,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Color       int                 `json:"color"`
+	Fields      []discordEmbedField `json:"fields,omitempty"`
+	Footer      *discordEmbedFooter `json:"footer,omitempty"`
+	Timestamp   string              `json:"timestamp,omitempty"`
+}
+
+// discordEmbedField is a field of a Discord embed.
+type discordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+// discordEmbedFooter is the footer of a Discord embed.
+type discordEmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// Send implements Agent.
+func (a *DiscordAgent) Send(ctx context.Context, n *Notification) error {
+	embed := discordEmbed{
+		Title:       truncate(n.Title, discordTitleLimit),
+		Description: truncate(n.Body, discordDescriptionLimit),
+		URL:         n.URL,
+		Color:       discordColors[n.Level],
+		Footer:      &discordEmbedFooter{Text: n.Event},
+	}
+	if embed.Color == 0 {
+		embed.Color = discordColors[LevelInfo]
+	}
+	if !n.CreatedAt.IsZero() {
+		embed.Timestamp = n.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	for i, field := range n.Fields {
+		if i == discordFieldLimit {
+			break
+		}
+		embed.Fields = append(embed.Fields, discordEmbedField{
+			Name:   truncate(field.Name, discordTitleLimit),
+			Value:  truncate(field.Value, discordFieldValueLimit),
+			Inline: true,
+		})
+	}
+
+	return postJSON(ctx, a.client, a.webhookURL, discordMessage{
+		Username:  a.username,
+		AvatarURL: a.avatarURL,
+		Embeds:    []discordEmbed{embed},
+	})
+}
+
+// truncate shortens s to at most limit runes, marking the cut with an
+// ellipsis.
+func truncate(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package notifications
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/lusoris/venio/internal/events"
+	"github.com/lusoris/venio/internal/i18n"
+)
+
+// instancePayload holds the instance fields shown in notifications.
+type instancePayload struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// FromEvent builds the notification for a domain event with text in the
+// localizer's locale. It reports false for events that do not produce
+// notifications.
+func FromEvent(event *events.Event, l *i18n.Localizer) (*Notification, bool) {
+	n := &Notification{
+		Event:     event.Type,
+		Key:       event.AggregateType + ":" + event.AggregateID,
+		Level:     LevelInfo,
+		URLLabel:  l.T("notification.open"),
+		CreatedAt: event.CreatedAt,
+	}
+
+	switch event.Type {
+	case events.InstanceCreated, events.InstanceUpdated:
+		var instance instancePayload
+		if err := json.Unmarshal(event.Payload, &instance); err != nil {
+			return nil, false
+		}
+
+		n.Title = l.T("notification." + event.Type + ".title")
+		n.Body = l.T("notification."+event.Type+".body", "type", instance.Type, "name", instance.Name)
+		n.Fields = []Field{
+			{Name: l.T("notification.field.name"), Value: instance.Name},
+			{Name: l.T("notification.field.type"), Value: instance.Type},
+			{Name: l.T("notification.field.url"), Value: instance.URL},
+		}
+		if event.Type == events.InstanceCreated {
+			n.Level = LevelSuccess
+		}
+
+	case events.InstanceDeleted:
+		n.Title = l.T("notification.instance.deleted.title")
+		n.Body = l.T("notification.instance.deleted.body", "id", event.AggregateID)
+		n.Level = LevelWarning
+
+	default:
+		return nil, false
+	}
+
+	return n, true
+}
+
+// Test returns the notification sent by the "test" admin action.
+func Test(l *i18n.Localizer) *Notification {
+	return &Notification{
+		Event:     "test",
+		Level:     LevelInfo,
+		Title:     l.T("notification.test.title"),
+		Body:      l.T("notification.test.body"),
+		URLLabel:  l.T("notification.open"),
+		CreatedAt: time.Now(),
+	}
+}
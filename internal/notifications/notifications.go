@@ -0,0 +1,110 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package notifications formats notifications and sends them through
+// notification agents: chat services such as Discord and push services
+// such as ntfy.
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Agent types.
+const (
+	TypeDiscord  = "discord"
+	TypeSlack    = "slack"
+	TypeTelegram = "telegram"
+	TypePushover = "pushover"
+	TypeGotify   = "gotify"
+	TypeNtfy     = "ntfy"
+)
+
+// Level sets the emphasis of a notification, e.g. the embed color.
+type Level string
+
+// Notification levels.
+const (
+	LevelInfo    Level = "info"
+	LevelSuccess Level = "success"
+	LevelWarning Level = "warning"
+	LevelError   Level = "error"
+)
+
+// ErrInvalidSettings is returned when an agent's settings are incomplete.
+var ErrInvalidSettings = errors.New("invalid notification agent settings")
+
+// Field is a labeled value shown alongside the notification body.
+type Field struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Notification is a channel-independent message.
+type Notification struct {
+	// Event is the event type that triggered the notification.
+	Event string `json:"event"`
+	// Key identifies what the notification is about, e.g. "instance:3".
+	// Notifications with the same event type and key may be throttled.
+	Key   string `json:"key,omitempty"`
+	Level Level  `json:"level"`
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	URL   string `json:"url,omitempty"`
+	// URLLabel is the translated caption of links to URL.
+	URLLabel  string    `json:"url_label,omitempty"`
+	Fields    []Field   `json:"fields,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Agent delivers notifications to one channel. Every agent type implements
+// it, so services route notifications without knowing the channel.
+type Agent interface {
+	Send(ctx context.Context, n *Notification) error
+}
+
+// NewAgent creates an agent of the given type from its settings.
+func NewAgent(agentType string, settings map[string]string, client *http.Client) (Agent, error) {
+	switch agentType {
+	case TypeDiscord:
+		return newDiscordAgent(settings, client)
+	case TypeSlack:
+		return newSlackAgent(settings, client)
+	case TypeTelegram:
+		return newTelegramAgent(settings, client)
+	case TypePushover:
+		return newPushoverAgent(settings, client)
+	case TypeGotify:
+		return newGotifyAgent(settings, client)
+	case TypeNtfy:
+		return newNtfyAgent(settings, client)
+	default:
+		return nil, fmt.Errorf("unknown notification agent type %q", agentType)
+	}
+}
+
+// linkLabel returns the caption for links to n.URL.
+func linkLabel(n *Notification) string {
+	if n.URLLabel != "" {
+		return n.URLLabel
+	}
+	return "Open"
+}
+
+// requireSetting returns settings[key] or an ErrInvalidSettings error when
+// it is empty.
+func requireSetting(settings map[string]string, key string) (string, error) {
+	value := settings[key]
+	if value == "" {
+		return "", fmt.Errorf("%w: %s is required", ErrInvalidSettings, key)
+	}
+	return value, nil
+}
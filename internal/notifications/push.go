@@ -0,0 +1,248 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// pushoverAPIURL is the Pushover message endpoint.
+const pushoverAPIURL = "https://api.pushover.net/1/messages.json"
+
+// defaultNtfyServer is used when an ntfy agent has no server_url.
+const defaultNtfyServer = "https://ntfy.sh"
+
+// pushPriorities maps levels to priorities on each service's scale:
+// Pushover -2..2, Gotify 0..10, and ntfy 1..5.
+var pushPriorities = map[Level]struct{ pushover, gotify, ntfy int }{
+	LevelInfo:    {0, 4, 3},
+	LevelSuccess: {0, 4, 3},
+	LevelWarning: {0, 6, 4},
+	LevelError:   {1, 8, 5},
+}
+
+// ntfyTags maps levels to ntfy tags, which ntfy shows as emojis.
+var ntfyTags = map[Level]string{
+	LevelInfo:    "information_source",
+	LevelSuccess: "white_check_mark",
+	LevelWarning: "warning",
+	LevelError:   "rotating_light",
+}
+
+// pushMessage renders the body and fields of n as plain text, the common
+// format of push services.
+func pushMessage(n *Notification) string {
+	var b strings.Builder
+	b.WriteString(n.Body)
+	for i, field := range n.Fields {
+		if i == 0 && b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "\n%s: %s", field.Name, field.Value)
+	}
+	if b.Len() == 0 {
+		return n.Title
+	}
+	return b.String()
+}
+
+// priorities returns the push priorities for a level.
+func priorities(level Level) struct{ pushover, gotify, ntfy int } {
+	if p, ok := pushPriorities[level]; ok {
+		return p
+	}
+	return pushPriorities[LevelInfo]
+}
+
+// PushoverAgent sends notifications through Pushover.
+//
+// Settings: app_token (required), user_key (required; a user or group
+// key), device, and sound.
+type PushoverAgent struct {
+	appToken string
+	userKey  string
+	device   string
+	sound    string
+	client   *http.Client
+}
+
+// newPushoverAgent creates a Pushover agent from its settings.
+func newPushoverAgent(settings map[string]string, client *http.Client) (*PushoverAgent, error) {
+	appToken, err := requireSetting(settings, "app_token")
+	if err != nil {
+		return nil, err
+	}
+	userKey, err := requireSetting(settings, "user_key")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PushoverAgent{
+		appToken: appToken,
+		userKey:  userKey,
+		device:   settings["device"],
+		sound:    settings["sound"],
+		client:   client,
+	}, nil
+}
+
+// Send implements Agent.
+func (a *PushoverAgent) Send(ctx context.Context, n *Notification) error {
+	form := url.Values{
+		"token":    {a.appToken},
+		"user":     {a.userKey},
+		"title":    {truncate(n.Title, 250)},
+		"message":  {truncate(pushMessage(n), 1024)},
+		"priority": {strconv.Itoa(priorities(n.Level).pushover)},
+	}
+	if n.URL != "" {
+		form.Set("url", n.URL)
+	}
+	if !n.CreatedAt.IsZero() {
+		form.Set("timestamp", strconv.FormatInt(n.CreatedAt.Unix(), 10))
+	}
+	if a.device != "" {
+		form.Set("device", a.device)
+	}
+	if a.sound != "" {
+		form.Set("sound", a.sound)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, pushoverAPIURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return do(a.client, req)
+}
+
+// GotifyAgent sends notifications to a Gotify server.
+//
+// Settings: server_url (required) and app_token (required).
+type GotifyAgent struct {
+	serverURL string
+	appToken  string
+	client    *http.Client
+}
+
+// newGotifyAgent creates a Gotify agent from its settings.
+func newGotifyAgent(settings map[string]string, client *http.Client) (*GotifyAgent, error) {
+	serverURL, err := requireSetting(settings, "server_url")
+	if err != nil {
+		return nil, err
+	}
+	appToken, err := requireSetting(settings, "app_token")
+	if err != nil {
+		return nil, err
+	}
+
+	return &GotifyAgent{serverURL: strings.TrimRight(serverURL, "/"), appToken: appToken, client: client}, nil
+}
+
+// gotifyMessage is the body of a Gotify message request.
+type gotifyMessage struct {
+	Title    string         `json:"title"`
+	Message  string         `json:"message"`
+	Priority int            `json:"priority"`
+	Extras   map[string]any `json:"extras,omitempty"`
+}
+
+// Send implements Agent.
+func (a *GotifyAgent) Send(ctx context.Context, n *Notification) error {
+	msg := gotifyMessage{
+		Title:    n.Title,
+		Message:  pushMessage(n),
+		Priority: priorities(n.Level).gotify,
+	}
+	if n.URL != "" {
+		msg.Extras = map[string]any{
+			"client::notification": map[string]any{"click": map[string]string{"url": n.URL}},
+		}
+	}
+
+	req, err := newJSONRequest(ctx, a.serverURL+"/message", msg)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Gotify-Key", a.appToken)
+
+	return do(a.client, req)
+}
+
+// NtfyAgent publishes notifications to an ntfy topic.
+//
+// Settings: topic (required), server_url (default https://ntfy.sh), and
+// either access_token or username and password for protected topics.
+type NtfyAgent struct {
+	topicURL    string
+	accessToken string
+	username    string
+	password    string
+	client      *http.Client
+}
+
+// newNtfyAgent creates an ntfy agent from its settings.
+func newNtfyAgent(settings map[string]string, client *http.Client) (*NtfyAgent, error) {
+	topic, err := requireSetting(settings, "topic")
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL := settings["server_url"]
+	if serverURL == "" {
+		serverURL = defaultNtfyServer
+	}
+	if settings["access_token"] != "" && settings["username"] != "" {
+		return nil, fmt.Errorf("%w: use either access_token or username and password", ErrInvalidSettings)
+	}
+
+	return &NtfyAgent{
+		topicURL:    strings.TrimRight(serverURL, "/") + "/" + url.PathEscape(topic),
+		accessToken: settings["access_token"],
+		username:    settings["username"],
+		password:    settings["password"],
+		client:      client,
+	}, nil
+}
+
+// Send implements Agent. The message is the request body; everything else
+// travels in headers.
+func (a *NtfyAgent) Send(ctx context.Context, n *Notification) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.topicURL, strings.NewReader(pushMessage(n)))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	level := n.Level
+	if _, ok := ntfyTags[level]; !ok {
+		level = LevelInfo
+	}
+	// Header values must be single-line ASCII; ntfy decodes RFC 2047.
+	req.Header.Set("Title", mime.QEncoding.Encode("utf-8", strings.Join(strings.Fields(n.Title), " ")))
+	req.Header.Set("Priority", strconv.Itoa(priorities(level).ntfy))
+	req.Header.Set("Tags", ntfyTags[level])
+	if n.URL != "" {
+		req.Header.Set("Click", n.URL)
+	}
+
+	switch {
+	case a.accessToken != "":
+		req.Header.Set("Authorization", "Bearer "+a.accessToken)
+	case a.username != "":
+		req.SetBasicAuth(a.username, a.password)
+	}
+
+	return do(a.client, req)
+}
@@ -0,0 +1,313 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// capturedRequest is what a test server received.
+type capturedRequest struct {
+	method string
+	path   string
+	header http.Header
+	body   string
+}
+
+// newCaptureServer starts a server that records the last request and
+// answers with status.
+func newCaptureServer(t *testing.T, status int) (*httptest.Server, *capturedRequest) {
+	t.Helper()
+
+	captured := &capturedRequest{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		*captured = capturedRequest{method: r.Method, path: r.URL.Path, header: r.Header.Clone(), body: string(body)}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("invalid token\n"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, captured
+}
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// redirectClient returns a client that sends every request to srv, for
+// agents with a fixed API URL.
+func redirectClient(srv *httptest.Server) *http.Client {
+	target, _ := url.Parse(srv.URL)
+	return &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = target.Scheme
+		req.URL.Host = target.Host
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+}
+
+func TestPushMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		n    Notification
+		want string
+	}{
+		{"body only", Notification{Title: "T", Body: "Body"}, "Body"},
+		{
+			name: "body and fields",
+			n:    Notification{Body: "Body", Fields: []Field{{"Instance", "radarr"}, {"Status", "down"}}},
+			want: "Body\n\nInstance: radarr\nStatus: down",
+		},
+		{"fields only", Notification{Fields: []Field{{"Instance", "radarr"}}}, "\nInstance: radarr"},
+		{"falls back to the title", Notification{Title: "Title"}, "Title"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pushMessage(&tt.n); got != tt.want {
+				t.Errorf("pushMessage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPushAgentInvalidSettings(t *testing.T) {
+	tests := []struct {
+		name      string
+		agentType string
+		settings  map[string]string
+	}{
+		{"pushover without user key", TypePushover, map[string]string{"app_token": "a"}},
+		{"pushover without app token", TypePushover, map[string]string{"user_key": "u"}},
+		{"gotify without server", TypeGotify, map[string]string{"app_token": "a"}},
+		{"gotify without app token", TypeGotify, map[string]string{"server_url": "https://push.example.com"}},
+		{"ntfy without topic", TypeNtfy, map[string]string{}},
+		{"ntfy with token and username", TypeNtfy, map[string]string{"topic": "t", "access_token": "tk", "username": "me"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAgent(tt.agentType, tt.settings, http.DefaultClient)
+			if !errors.Is(err, ErrInvalidSettings) {
+				t.Errorf("NewAgent() error = %v, want ErrInvalidSettings", err)
+			}
+		})
+	}
+}
+
+func TestPushoverAgentSend(t *testing.T) {
+	srv, captured := newCaptureServer(t, http.StatusOK)
+	agent, err := NewAgent(TypePushover, map[string]string{
+		"app_token": "atoken",
+		"user_key":  "ukey",
+		"device":    "phone",
+		"sound":     "magic",
+	}, redirectClient(srv))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createdAt := time.Unix(1760000000, 0)
+	err = agent.Send(context.Background(), &Notification{
+		Level:     LevelError,
+		Title:     "Instance down",
+		Body:      "Radarr is unreachable",
+		URL:       "https://venio.example.com/instances/1",
+		CreatedAt: createdAt,
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	if captured.path != "/1/messages.json" {
+		t.Errorf("path = %q, want /1/messages.json", captured.path)
+	}
+	form, err := url.ParseQuery(captured.body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"token":     "atoken",
+		"user":      "ukey",
+		"title":     "Instance down",
+		"message":   "Radarr is unreachable",
+		"priority":  "1",
+		"url":       "https://venio.example.com/instances/1",
+		"timestamp": "1760000000",
+		"device":    "phone",
+		"sound":     "magic",
+	}
+	for key, value := range want {
+		if got := form.Get(key); got != value {
+			t.Errorf("form %s = %q, want %q", key, got, value)
+		}
+	}
+}
+
+func TestGotifyAgentSend(t *testing.T) {
+	srv, captured := newCaptureServer(t, http.StatusOK)
+	agent, err := NewAgent(TypeGotify, map[string]string{
+		"server_url": srv.URL + "/gotify/",
+		"app_token":  "Atoken",
+	}, srv.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = agent.Send(context.Background(), &Notification{
+		Level: LevelWarning,
+		Title: "Disk almost full",
+		Body:  "90% used",
+		URL:   "https://venio.example.com",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	if captured.path != "/gotify/message" {
+		t.Errorf("path = %q, want /gotify/message", captured.path)
+	}
+	if got := captured.header.Get("X-Gotify-Key"); got != "Atoken" {
+		t.Errorf("X-Gotify-Key = %q, want Atoken", got)
+	}
+	var msg struct {
+		Title    string `json:"title"`
+		Message  string `json:"message"`
+		Priority int    `json:"priority"`
+		Extras   struct {
+			Notification struct {
+				Click struct {
+					URL string `json:"url"`
+				} `json:"click"`
+			} `json:"client::notification"`
+		} `json:"extras"`
+	}
+	if err := json.Unmarshal([]byte(captured.body), &msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Title != "Disk almost full" || msg.Message != "90% used" || msg.Priority != 6 {
+		t.Errorf("message = %+v", msg)
+	}
+	if msg.Extras.Notification.Click.URL != "https://venio.example.com" {
+		t.Errorf("click URL = %q", msg.Extras.Notification.Click.URL)
+	}
+}
+
+func TestNtfyAgentSend(t *testing.T) {
+	tests := []struct {
+		name         string
+		settings     map[string]string
+		n            Notification
+		wantHeaders  map[string]string
+		wantAuth     string
+		wantNoHeader string
+	}{
+		{
+			name:     "access token",
+			settings: map[string]string{"topic": "venio alerts", "access_token": "tk_123"},
+			n:        Notification{Level: LevelError, Title: "Instance down", Body: "Radarr", URL: "https://venio.example.com"},
+			wantHeaders: map[string]string{
+				"Title":    "Instance down",
+				"Priority": "5",
+				"Tags":     "rotating_light",
+				"Click":    "https://venio.example.com",
+			},
+			wantAuth: "Bearer tk_123",
+		},
+		{
+			name:     "basic auth",
+			settings: map[string]string{"topic": "venio alerts", "username": "me", "password": "secret"},
+			n:        Notification{Level: LevelSuccess, Title: "Sync finished"},
+			wantHeaders: map[string]string{
+				"Title":    "Sync finished",
+				"Priority": "3",
+				"Tags":     "white_check_mark",
+			},
+			wantAuth:     "Basic bWU6c2VjcmV0",
+			wantNoHeader: "Click",
+		},
+		{
+			name:     "non-ASCII multi-line title and unknown level",
+			settings: map[string]string{"topic": "venio alerts"},
+			n:        Notification{Level: "critical", Title: "Größe\nüberschritten"},
+			wantHeaders: map[string]string{
+				"Title":    "=?utf-8?q?Gr=C3=B6=C3=9Fe_=C3=BCberschritten?=",
+				"Priority": "3",
+				"Tags":     "information_source",
+			},
+			wantNoHeader: "Authorization",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, captured := newCaptureServer(t, http.StatusOK)
+			tt.settings["server_url"] = srv.URL
+			agent, err := NewAgent(TypeNtfy, tt.settings, srv.Client())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := agent.Send(context.Background(), &tt.n); err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+
+			if captured.path != "/venio alerts" {
+				t.Errorf("path = %q, want the topic", captured.path)
+			}
+			if want := pushMessage(&tt.n); captured.body != want {
+				t.Errorf("body = %q, want %q", captured.body, want)
+			}
+			for key, value := range tt.wantHeaders {
+				if got := captured.header.Get(key); got != value {
+					t.Errorf("header %s = %q, want %q", key, got, value)
+				}
+			}
+			if tt.wantAuth != "" {
+				if got := captured.header.Get("Authorization"); got != tt.wantAuth {
+					t.Errorf("Authorization = %q, want %q", got, tt.wantAuth)
+				}
+			}
+			if tt.wantNoHeader != "" && captured.header.Get(tt.wantNoHeader) != "" {
+				t.Errorf("header %s = %q, want none", tt.wantNoHeader, captured.header.Get(tt.wantNoHeader))
+			}
+		})
+	}
+}
+
+func TestNtfyAgentDefaultServer(t *testing.T) {
+	agent, err := newNtfyAgent(map[string]string{"topic": "venio"}, http.DefaultClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if agent.topicURL != "https://ntfy.sh/venio" {
+		t.Errorf("topicURL = %q, want https://ntfy.sh/venio", agent.topicURL)
+	}
+}
+
+func TestPushAgentSendError(t *testing.T) {
+	srv, _ := newCaptureServer(t, http.StatusUnauthorized)
+	agent, err := NewAgent(TypeGotify, map[string]string{"server_url": srv.URL, "app_token": "wrong"}, srv.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = agent.Send(context.Background(), &Notification{Title: "Test"})
+	if err == nil || !strings.Contains(err.Error(), "401 Unauthorized: invalid token") {
+		t.Errorf("Send() error = %v, want the status and response body", err)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Slack Block Kit limits.
+const (
+	slackHeaderLimit     = 150
+	slackTextLimit       = 3000
+	slackFieldLimit      = 10
+	slackFieldValueLimit = 2000
+)
+
+// slackRoutePrefix marks settings that route event types to another
+// incoming webhook, e.g. "route:instance.deleted" or "route:instance.*".
+const slackRoutePrefix = "route:"
+
+// slackEmoji prefixes the header according to the level.
+var slackEmoji = map[Level]string{
+	LevelInfo:    ":information_source:",
+	LevelSuccess: ":white_check_mark:",
+	LevelWarning: ":warning:",
+	LevelError:   ":rotating_light:",
+}
+
+// slackEscaper escapes the characters Slack reserves in mrkdwn text.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// SlackAgent posts notifications formatted with Block Kit to Slack incoming
+// webhooks. Each incoming webhook posts to one channel, so routing an event
+// type to a channel means routing it to that channel's webhook.
+//
+// Settings: webhook_url (required), and any number of "route:<event>"
+// entries holding a webhook URL. <event> is an event type or a prefix
+// ending in "*"; the most specific route wins.
+type SlackAgent struct {
+	webhookURL string
+	routes     map[string]string
+	client     *http.Client
+}
+
+// newSlackAgent creates a Slack agent from its settings.
+func newSlackAgent(settings map[string]string, client *http.Client) (*SlackAgent, error) {
+	webhookURL, err := requireSetting(settings, "webhook_url")
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make(map[string]string)
+	for key, value := range settings {
+		pattern, ok := strings.CutPrefix(key, slackRoutePrefix)
+		if !ok {
+			continue
+		}
+		if pattern == "" || value == "" {
+			return nil, fmt.Errorf("%w: %s needs an event type and a webhook URL", ErrInvalidSettings, key)
+		}
+		routes[pattern] = value
+	}
+
+	return &SlackAgent{webhookURL: webhookURL, routes: routes, client: client}, nil
+}
+
+// route returns the webhook URL for an event type.
+func (a *SlackAgent) route(eventType string) string {
+	if url, ok := a.routes[eventType]; ok {
+		return url
+	}
+
+	url, longest := a.webhookURL, -1
+	for pattern, target := range a.routes {
+		prefix, ok := strings.CutSuffix(pattern, "*")
+		if ok && strings.HasPrefix(eventType, prefix) && len(prefix) > longest {
+			url, longest = target, len(prefix)
+		}
+	}
+	return url
+}
+
+// slackMessage is the body of a Slack incoming webhook request. Text is the
+// fallback shown in notifications.
+type slackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+// slackBlock is a Block Kit layout block. Elements hold slackText or
+// slackButton values.
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Fields   []slackText `json:"fields,omitempty"`
+	Elements []any       `json:"elements,omitempty"`
+}
+
+// slackText is a Block Kit text object.
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// slackButton is a Block Kit link button.
+type slackButton struct {
+	Type string    `json:"type"`
+	Text slackText `json:"text"`
+	URL  string    `json:"url"`
+}
+
+// Send implements Agent.
+func (a *SlackAgent) Send(ctx context.Context, n *Notification) error {
+	return postJSON(ctx, a.client, a.route(n.Event), slackBlocks(n))
+}
+
+// slackBlocks formats n as a Block Kit message.
+func slackBlocks(n *Notification) slackMessage {
+	emoji := slackEmoji[n.Level]
+	if emoji == "" {
+		emoji = slackEmoji[LevelInfo]
+	}
+
+	blocks := []slackBlock{{
+		Type: "header",
+		Text: &slackText{Type: "plain_text", Text: truncate(emoji+" "+n.Title, slackHeaderLimit)},
+	}}
+	if n.Body != "" {
+		blocks = append(blocks, slackBlock{
+			Type: "section",
+			Text: &slackText{Type: "mrkdwn", Text: truncate(slackEscaper.Replace(n.Body), slackTextLimit)},
+		})
+	}
+
+	if len(n.Fields) > 0 {
+		shown := n.Fields[:min(len(n.Fields), slackFieldLimit)]
+		fields := make([]slackText, 0, len(shown))
+		for _, field := range shown {
+			text := fmt.Sprintf("*%s*\n%s", slackEscaper.Replace(field.Name), slackEscaper.Replace(field.Value))
+			fields = append(fields, slackText{Type: "mrkdwn", Text: truncate(text, slackFieldValueLimit)})
+		}
+		blocks = append(blocks, slackBlock{Type: "section", Fields: fields})
+	}
+
+	if n.URL != "" {
+		blocks = append(blocks, slackBlock{
+			Type: "actions",
+			Elements: []any{slackButton{
+				Type: "button",
+				Text: slackText{Type: "plain_text", Text: linkLabel(n)},
+				URL:  n.URL,
+			}},
+		})
+	}
+
+	footer := n.Event
+	if !n.CreatedAt.IsZero() {
+		// Slack renders the date in each reader's timezone.
+		footer += fmt.Sprintf(" · <!date^%d^{date_short_pretty} {time}|%s>",
+			n.CreatedAt.Unix(), n.CreatedAt.UTC().Format("2006-01-02 15:04 UTC"))
+	}
+	blocks = append(blocks, slackBlock{
+		Type:     "context",
+		Elements: []any{slackText{Type: "mrkdwn", Text: footer}},
+	})
+
+	return slackMessage{Text: n.Title, Blocks: blocks}
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// telegramAPIURL is the Bot API endpoint; the token follows "bot".
+const telegramAPIURL = "https://api.telegram.org/bot"
+
+// telegramMessageLimit is the maximum length of a message text.
+const telegramMessageLimit = 4096
+
+// telegramEmoji prefixes the title according to the level.
+var telegramEmoji = map[Level]string{
+	LevelInfo:    "ℹ️",
+	LevelSuccess: "✅",
+	LevelWarning: "⚠️",
+	LevelError:   "🚨",
+}
+
+// TelegramAgent sends notifications through a Telegram bot to one chat.
+//
+// Settings: bot_token (required), chat_id (required), message_thread_id
+// for forum topics, and silent ("true" sends without sound).
+type TelegramAgent struct {
+	botToken string
+	chatID   string
+	threadID int
+	silent   bool
+	client   *http.Client
+}
+
+// newTelegramAgent creates a Telegram agent from its settings.
+func newTelegramAgent(settings map[string]string, client *http.Client) (*TelegramAgent, error) {
+	botToken, err := requireSetting(settings, "bot_token")
+	if err != nil {
+		return nil, err
+	}
+	chatID, err := requireSetting(settings, "chat_id")
+	if err != nil {
+		return nil, err
+	}
+
+	agent := &TelegramAgent{botToken: botToken, chatID: chatID, client: client}
+	if value := settings["message_thread_id"]; value != "" {
+		agent.threadID, err = strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: message_thread_id must be a number", ErrInvalidSettings)
+		}
+	}
+	if value := settings["silent"]; value != "" {
+		agent.silent, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: silent must be true or false", ErrInvalidSettings)
+		}
+	}
+	return agent, nil
+}
+
+// telegramMessage is the body of a sendMessage request.
+type telegramMessage struct {
+	ChatID              string `json:"chat_id"`
+	MessageThreadID     int    `json:"message_thread_id,omitempty"`
+	Text                string `json:"text"`
+	ParseMode           string `json:"parse_mode"`
+	DisableNotification bool   `json:"disable_notification,omitempty"`
+	LinkPreviewOptions  struct {
+		IsDisabled bool `json:"is_disabled"`
+	} `json:"link_preview_options"`
+}
+
+// Send implements Agent.
+func (a *TelegramAgent) Send(ctx context.Context, n *Notification) error {
+	msg := telegramMessage{
+		ChatID:              a.chatID,
+		MessageThreadID:     a.threadID,
+		Text:                telegramText(n),
+		ParseMode:           "HTML",
+		DisableNotification: a.silent,
+	}
+	msg.LinkPreviewOptions.IsDisabled = true
+
+	return postJSON(ctx, a.client, telegramAPIURL+a.botToken+"/sendMessage", msg)
+}
+
+// telegramText formats n as Telegram HTML. The body is cut before markup
+// is added so truncation cannot break a tag.
+func telegramText(n *Notification) string {
+	emoji := telegramEmoji[n.Level]
+	if emoji == "" {
+		emoji = telegramEmoji[LevelInfo]
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s <b>%s</b>\n", emoji, html.EscapeString(n.Title))
+	if n.Body != "" {
+		fmt.Fprintf(&b, "\n%s\n", html.EscapeString(truncate(n.Body, telegramMessageLimit/2)))
+	}
+	if len(n.Fields) > 0 {
+		b.WriteString("\n")
+		for _, field := range n.Fields {
+			fmt.Fprintf(&b, "<b>%s:</b> %s\n", html.EscapeString(field.Name), html.EscapeString(field.Value))
+		}
+	}
+	if n.URL != "" {
+		fmt.Fprintf(&b, "\n<a href=\"%s\">%s</a>\n", html.EscapeString(n.URL), html.EscapeString(linkLabel(n)))
+	}
+	fmt.Fprintf(&b, "\n<i>%s</i>", html.EscapeString(n.Event))
+
+	text := b.String()
+	if len([]rune(text)) > telegramMessageLimit {
+		// Only an unusual number of fields gets here; fall back to the
+		// title and body without markup, which can be cut anywhere.
+		return html.EscapeString(truncate(n.Title+"\n\n"+n.Body, telegramMessageLimit))
+	}
+	return text
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// throttlePrefix namespaces throttle keys in Redis.
+const throttlePrefix = "venio:notification:throttle:"
+
+// Throttle limits how often an agent is notified about the same subject, so
+// a bulk sync that touches one item many times does not flood a channel.
+// Windows are configured per event type; "*" sets the default and a missing
+// or zero window disables throttling.
+type Throttle struct {
+	redis   redis.UniversalClient
+	windows map[string]time.Duration
+}
+
+// NewThrottle creates a throttle that keeps its state in Redis, so it holds
+// across API and worker processes.
+func NewThrottle(client redis.UniversalClient, windows map[string]time.Duration) *Throttle {
+	return &Throttle{redis: client, windows: windows}
+}
+
+// Allow reports whether n may be sent to the agent now and, if so, starts
+// the window in which further notifications with the same event type and
+// Key are suppressed.
+func (t *Throttle) Allow(ctx context.Context, agentID int64, n *Notification) (bool, error) {
+	window, ok := t.windows[n.Event]
+	if !ok {
+		window = t.windows["*"]
+	}
+	if window <= 0 || n.Key == "" {
+		return true, nil
+	}
+
+	allowed, err := t.redis.SetNX(ctx, throttleKey(agentID, n), 1, window).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check notification throttle: %w", err)
+	}
+	return allowed, nil
+}
+
+// Release ends the window started by Allow, for when the notification was
+// not sent after all.
+func (t *Throttle) Release(ctx context.Context, agentID int64, n *Notification) error {
+	if err := t.redis.Del(ctx, throttleKey(agentID, n)).Err(); err != nil {
+		return fmt.Errorf("failed to release notification throttle: %w", err)
+	}
+	return nil
+}
+
+// throttleKey returns the Redis key of the window for n at an agent.
+func throttleKey(agentID int64, n *Notification) string {
+	return fmt.Sprintf("%s%d:%s:%s", throttlePrefix, agentID, n.Event, n.Key)
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package outbox relays domain events from the transactional outbox table to
+// in-process subscribers. The relay polls Postgres directly, so events are
+// never lost while Redis or the task queue is unavailable.
+package outbox
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/events"
+	"github.com/lusoris/venio/internal/repositories"
+)
+
+// purgeInterval is how often published events past retention are deleted.
+const purgeInterval = time.Hour
+
+// Config tunes the relay. Every setting must be positive, which the
+// configuration validation ensures.
+type Config struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	// A failed event is retried after RetryBaseDelay, doubled with every
+	// further failure up to RetryMaxDelay.
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	Retention      time.Duration
+}
+
+// Relay delivers outbox events to a dispatcher.
+type Relay struct {
+	pool       *pgxpool.Pool
+	repo       *repositories.OutboxRepository
+	dispatcher *events.Dispatcher
+	cfg        Config
+}
+
+// NewRelay creates a relay.
+func NewRelay(pool *pgxpool.Pool, dispatcher *events.Dispatcher, cfg Config) *Relay {
+	return &Relay{
+		pool:       pool,
+		repo:       repositories.NewOutboxRepository(pool),
+		dispatcher: dispatcher,
+		cfg:        cfg,
+	}
+}
+
+// Run relays events until ctx is canceled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	var lastPurge time.Time
+	for {
+		// Drain full batches back to back before waiting for the next tick,
+		// unless a batch published nothing, e.g. while Redis is down.
+		for {
+			fetched, published, err := r.RelayOnce(ctx)
+			if err != nil {
+				log.Printf("Outbox relay failed: %v", err)
+				break
+			}
+			if fetched < r.cfg.BatchSize || published == 0 {
+				break
+			}
+		}
+
+		if time.Since(lastPurge) >= purgeInterval {
+			r.purge(ctx)
+			lastPurge = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayOnce delivers one batch of due events and returns how many were
+// fetched and how many of them published. A failed delivery is recorded
+// with the handlers that succeeded, and only the others are run again
+// after the retry delay.
+func (r *Relay) RelayOnce(ctx context.Context) (fetched, published int, err error) {
+	err = database.WithTx(ctx, r.pool, func(tx pgx.Tx) error {
+		repo := r.repo.WithTx(tx)
+
+		pending, err := repo.LockPending(ctx, r.cfg.BatchSize, r.cfg.MaxAttempts)
+		if err != nil {
+			return err
+		}
+		fetched = len(pending)
+
+		for _, event := range pending {
+			delivered, err := r.dispatcher.Dispatch(ctx, event.Event, event.DeliveredTo)
+			if err != nil {
+				attempts := event.Attempts + 1
+				if attempts >= r.cfg.MaxAttempts {
+					log.Printf("Outbox event %d (%s) failed %d times and is left for inspection: %v", event.ID, event.Type, attempts, err)
+				} else {
+					log.Printf("Outbox event %d (%s) delivery failed, attempt %d of %d: %v", event.ID, event.Type, attempts, r.cfg.MaxAttempts, err)
+				}
+				deliveredTo := append(slices.Clip(event.DeliveredTo), delivered...)
+				if err := repo.MarkFailed(ctx, event.ID, deliveredTo, time.Now().Add(r.retryDelay(attempts)), err); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := repo.MarkPublished(ctx, event.ID); err != nil {
+				return err
+			}
+			published++
+		}
+		return nil
+	})
+	return fetched, published, err
+}
+
+// retryDelay returns how long to wait after the given number of failed
+// attempts: RetryBaseDelay, doubled for every further attempt, up to
+// RetryMaxDelay.
+func (r *Relay) retryDelay(attempts int) time.Duration {
+	delay := r.cfg.RetryBaseDelay
+	for i := 1; i < attempts && delay < r.cfg.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, r.cfg.RetryMaxDelay)
+}
+
+// purge deletes published events older than the retention period.
+func (r *Relay) purge(ctx context.Context) {
+	deleted, err := r.repo.DeletePublishedBefore(ctx, time.Now().Add(-r.cfg.Retention))
+	if err != nil {
+		log.Printf("Outbox purge failed: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("Purged %d published outbox events", deleted)
+	}
+}
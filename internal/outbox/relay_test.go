@@ -0,0 +1,185 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package outbox
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/events"
+	"github.com/lusoris/venio/internal/repositories"
+	"github.com/lusoris/venio/migrations"
+)
+
+func TestRetryDelay(t *testing.T) {
+	r := &Relay{cfg: Config{RetryBaseDelay: 10 * time.Second, RetryMaxDelay: time.Minute}}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{3, 40 * time.Second},
+		{4, time.Minute},
+		{100, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := r.retryDelay(tt.attempts); got != tt.want {
+			t.Errorf("retryDelay(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+// newTestPool connects to the database at VENIO_TEST_DATABASE_URL, applies
+// the migrations, and empties the outbox. The test is skipped when no
+// database is configured.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	url := os.Getenv("VENIO_TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("VENIO_TEST_DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatalf("invalid VENIO_TEST_DATABASE_URL: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	migrator, err := database.NewMigrator(pool, migrations.FS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pool.Exec(ctx, "TRUNCATE outbox_events"); err != nil {
+		t.Fatal(err)
+	}
+	return pool
+}
+
+func TestRelayOnceRetriesFailedHandlersOnly(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+
+	event, err := events.New(events.InstanceCreated, events.AggregateInstance, "1", map[string]any{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repositories.NewOutboxRepository(pool).Add(ctx, event); err != nil {
+		t.Fatal(err)
+	}
+
+	var delivered, attempts int
+	dispatcher := events.NewDispatcher()
+	dispatcher.SubscribeAll("delivered", func(context.Context, *events.Event) error {
+		delivered++
+		return nil
+	})
+	dispatcher.SubscribeAll("flaky", func(context.Context, *events.Event) error {
+		attempts++
+		if attempts == 1 {
+			return errors.New("redis is down")
+		}
+		return nil
+	})
+	relay := NewRelay(pool, dispatcher, Config{
+		BatchSize:      10,
+		MaxAttempts:    3,
+		RetryBaseDelay: time.Hour,
+		RetryMaxDelay:  time.Hour,
+	})
+
+	fetched, published, err := relay.RelayOnce(ctx)
+	if err != nil || fetched != 1 || published != 0 {
+		t.Fatalf("first RelayOnce() = %d, %d, %v; want 1 fetched, 0 published", fetched, published, err)
+	}
+
+	var deliveredTo []string
+	var nextAttempt time.Time
+	err = pool.QueryRow(ctx, "SELECT delivered_to, next_attempt_at FROM outbox_events WHERE id = $1", event.ID).
+		Scan(&deliveredTo, &nextAttempt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(deliveredTo, []string{"delivered"}) {
+		t.Errorf("delivered_to = %v, want [delivered]", deliveredTo)
+	}
+	if time.Until(nextAttempt) < 59*time.Minute {
+		t.Errorf("next_attempt_at = %v, want about an hour from now", nextAttempt)
+	}
+
+	// The event is not due yet.
+	if fetched, _, err := relay.RelayOnce(ctx); err != nil || fetched != 0 {
+		t.Fatalf("RelayOnce() before the retry delay = %d, %v; want nothing fetched", fetched, err)
+	}
+
+	if _, err := pool.Exec(ctx, "UPDATE outbox_events SET next_attempt_at = NOW() WHERE id = $1", event.ID); err != nil {
+		t.Fatal(err)
+	}
+	fetched, published, err = relay.RelayOnce(ctx)
+	if err != nil || fetched != 1 || published != 1 {
+		t.Fatalf("retried RelayOnce() = %d, %d, %v; want 1 fetched, 1 published", fetched, published, err)
+	}
+	if delivered != 1 || attempts != 2 {
+		t.Errorf("handlers ran %d and %d times, want 1 and 2", delivered, attempts)
+	}
+}
+
+func TestRelayOnceStopsAtMaxAttempts(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+
+	event, err := events.New(events.InstanceDeleted, events.AggregateInstance, "1", map[string]any{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repositories.NewOutboxRepository(pool).Add(ctx, event); err != nil {
+		t.Fatal(err)
+	}
+
+	dispatcher := events.NewDispatcher()
+	dispatcher.SubscribeAll("failing", func(context.Context, *events.Event) error {
+		return errors.New("failed")
+	})
+	relay := NewRelay(pool, dispatcher, Config{
+		BatchSize:      10,
+		MaxAttempts:    2,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  time.Millisecond,
+	})
+
+	for i := 0; i < 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+		if fetched, _, err := relay.RelayOnce(ctx); err != nil || fetched != 1 {
+			t.Fatalf("RelayOnce() %d = %d, %v; want 1 fetched", i+1, fetched, err)
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	if fetched, _, err := relay.RelayOnce(ctx); err != nil || fetched != 0 {
+		t.Fatalf("RelayOnce() after %d failures = %d, %v; want nothing fetched", 2, fetched, err)
+	}
+
+	var lastError string
+	if err := pool.QueryRow(ctx, "SELECT last_error FROM outbox_events WHERE id = $1", event.ID).Scan(&lastError); err != nil {
+		t.Fatal(err)
+	}
+	if lastError != "failing: failed" {
+		t.Errorf("last_error = %q, want %q", lastError, "failing: failed")
+	}
+}
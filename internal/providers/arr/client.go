@@ -0,0 +1,91 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package arr implements a client for the Radarr/Sonarr v3 API.
+package arr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/requestid"
+)
+
+// Client talks to a single Radarr or Sonarr instance.
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the given instance.
+func NewClient(instance *models.Instance, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    strings.TrimRight(instance.URL, "/"),
+		apiKey:     instance.APIKey,
+		httpClient: httpClient,
+	}
+}
+
+// QualityProfiles returns the quality profiles configured in the instance.
+func (c *Client) QualityProfiles(ctx context.Context) ([]models.QualityProfile, error) {
+	var profiles []models.QualityProfile
+	if err := c.do(ctx, http.MethodGet, "/api/v3/qualityprofile", nil, &profiles); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// do sends a JSON request and decodes the JSON response into out.
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("X-Api-Key", c.apiKey)
+	req.Header.Set("Accept", "application/json")
+	requestid.SetHeader(req)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("arr request %s %s failed: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return fmt.Errorf("arr request %s %s returned status %d", method, path, resp.StatusCode)
+	}
+
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode arr response: %w", err)
+	}
+	return nil
+}
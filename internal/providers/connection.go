@@ -0,0 +1,74 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package providers contains clients for external services such as Arr
+// applications, media servers, and metadata providers.
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/requestid"
+)
+
+// Errors returned by TestConnection.
+var (
+	ErrUnauthorized       = errors.New("instance rejected the API key")
+	ErrUnsupportedService = errors.New("unsupported instance type")
+)
+
+// statusEndpoint returns the path and auth header used to probe an instance.
+func statusEndpoint(instanceType models.InstanceType) (path, header string, err error) {
+	switch instanceType {
+	case models.InstanceTypeRadarr, models.InstanceTypeSonarr:
+		return "/api/v3/system/status", "X-Api-Key", nil
+	case models.InstanceTypePlex:
+		return "/", "X-Plex-Token", nil
+	case models.InstanceTypeJellyfin:
+		return "/System/Info", "X-Emby-Token", nil
+	default:
+		return "", "", ErrUnsupportedService
+	}
+}
+
+// TestConnection verifies that an instance is reachable and accepts apiKey.
+func TestConnection(ctx context.Context, client *http.Client, instanceType models.InstanceType, baseURL, apiKey string) error {
+	path, header, err := statusEndpoint(instanceType)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(baseURL, "/")+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set(header, apiKey)
+	req.Header.Set("Accept", "application/json")
+	requestid.SetHeader(req)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach instance: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case resp.StatusCode >= 300:
+		return fmt.Errorf("instance returned unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package ratelimit limits how often a client may do something, with the
+// state kept in Redis so the limit holds across API instances.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix namespaces rate limit keys in Redis.
+const keyPrefix = "venio:ratelimit:"
+
+// gcra implements the generic cell rate algorithm, a token bucket that
+// stores a single timestamp: the theoretical arrival time (TAT) at which
+// the bucket is full again. Each request moves the TAT one emission
+// interval into the future; a request is refused when that would put the
+// TAT further ahead than the burst allows. Reading, checking, and writing
+// happen in one script, so concurrent requests cannot overshoot the limit,
+// and the clock is Redis's, so API instances agree on it.
+//
+// KEYS[1] is the bucket, ARGV[1] the burst, ARGV[2] the rate, and ARGV[3]
+// the period in microseconds. The script returns whether the request is
+// allowed, the remaining requests, and the microseconds until a retry may
+// succeed and until the bucket is full.
+var gcra = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local emission = tonumber(ARGV[3]) / tonumber(ARGV[2])
+local tolerance = emission * burst
+
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+
+local tat = tonumber(redis.call("GET", KEYS[1]))
+if not tat or tat < now then
+	tat = now
+end
+
+local new_tat = tat + emission
+local diff = now - (new_tat - tolerance)
+if diff < 0 then
+	return {0, 0, math.ceil(-diff), math.ceil(tat - now)}
+end
+
+redis.call("SET", KEYS[1], string.format("%.0f", new_tat), "PX", math.ceil((new_tat - now) / 1000))
+return {1, math.floor(diff / emission), 0, math.ceil(new_tat - now)}
+`)
+
+// Limit allows Rate requests per Period on average, and up to Burst at
+// once after a quiet spell.
+type Limit struct {
+	Rate   int
+	Period time.Duration
+	Burst  int
+}
+
+// Result is the outcome of a rate limit check.
+type Result struct {
+	Allowed bool
+	// Limit is the size of the bucket, i.e. the burst.
+	Limit     int
+	Remaining int
+	// RetryAfter is how long a refused client must wait; zero when allowed.
+	RetryAfter time.Duration
+	// ResetAfter is how long until the full burst is available again.
+	ResetAfter time.Duration
+}
+
+// Limiter checks rate limits against Redis.
+type Limiter struct {
+	redis redis.UniversalClient
+}
+
+// New creates a limiter backed by the given Redis client.
+func New(client redis.UniversalClient) *Limiter {
+	return &Limiter{redis: client}
+}
+
+// Allow takes one request from the bucket identified by key, if the limit
+// permits it.
+func (l *Limiter) Allow(ctx context.Context, key string, limit Limit) (*Result, error) {
+	values, err := gcra.Run(ctx, l.redis, []string{keyPrefix + key},
+		limit.Burst, limit.Rate, limit.Period.Microseconds()).Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check rate limit: %w", err)
+	}
+	if len(values) != 4 {
+		return nil, fmt.Errorf("failed to check rate limit: unexpected script result %v", values)
+	}
+
+	return &Result{
+		Allowed:    values[0] == 1,
+		Limit:      limit.Burst,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Microsecond,
+		ResetAfter: time.Duration(values[3]) * time.Microsecond,
+	}, nil
+}
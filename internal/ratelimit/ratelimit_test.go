@@ -0,0 +1,137 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// newTestLimiter connects to the Redis server at VENIO_TEST_REDIS_URL and
+// returns a limiter and a bucket key unique to the test. The test is
+// skipped when no server is configured.
+func newTestLimiter(t *testing.T) (*Limiter, string) {
+	t.Helper()
+
+	url := os.Getenv("VENIO_TEST_REDIS_URL")
+	if url == "" {
+		t.Skip("VENIO_TEST_REDIS_URL is not set")
+	}
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		t.Fatalf("invalid VENIO_TEST_REDIS_URL: %v", err)
+	}
+
+	client := redis.NewClient(opts)
+	t.Cleanup(func() { client.Close() })
+
+	key := fmt.Sprintf("test:%s:%d", t.Name(), time.Now().UnixNano())
+	t.Cleanup(func() { client.Del(context.Background(), keyPrefix+key) })
+
+	return New(client), key
+}
+
+// within reports whether got is within tolerance of want.
+func within(got, want, tolerance time.Duration) bool {
+	return got >= want-tolerance && got <= want+tolerance
+}
+
+func TestAllowBurst(t *testing.T) {
+	limiter, key := newTestLimiter(t)
+	limit := Limit{Rate: 1, Period: time.Hour, Burst: 3}
+
+	tests := []struct {
+		allowed    bool
+		remaining  int
+		retryAfter time.Duration
+		resetAfter time.Duration
+	}{
+		{allowed: true, remaining: 2, resetAfter: time.Hour},
+		{allowed: true, remaining: 1, resetAfter: 2 * time.Hour},
+		{allowed: true, remaining: 0, resetAfter: 3 * time.Hour},
+		{allowed: false, remaining: 0, retryAfter: time.Hour, resetAfter: 3 * time.Hour},
+		{allowed: false, remaining: 0, retryAfter: time.Hour, resetAfter: 3 * time.Hour},
+	}
+
+	for i, tt := range tests {
+		result, err := limiter.Allow(context.Background(), key, limit)
+		if err != nil {
+			t.Fatalf("request %d: Allow() error = %v", i+1, err)
+		}
+		if result.Allowed != tt.allowed {
+			t.Errorf("request %d: Allowed = %v, want %v", i+1, result.Allowed, tt.allowed)
+		}
+		if result.Limit != limit.Burst {
+			t.Errorf("request %d: Limit = %d, want %d", i+1, result.Limit, limit.Burst)
+		}
+		if result.Remaining != tt.remaining {
+			t.Errorf("request %d: Remaining = %d, want %d", i+1, result.Remaining, tt.remaining)
+		}
+		if !within(result.RetryAfter, tt.retryAfter, time.Second) {
+			t.Errorf("request %d: RetryAfter = %v, want %v", i+1, result.RetryAfter, tt.retryAfter)
+		}
+		if !within(result.ResetAfter, tt.resetAfter, time.Second) {
+			t.Errorf("request %d: ResetAfter = %v, want %v", i+1, result.ResetAfter, tt.resetAfter)
+		}
+	}
+}
+
+func TestAllowRefill(t *testing.T) {
+	limiter, key := newTestLimiter(t)
+	limit := Limit{Rate: 10, Period: time.Second, Burst: 1}
+	ctx := context.Background()
+
+	result, err := limiter.Allow(ctx, key, limit)
+	if err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	if !result.Allowed {
+		t.Fatal("first request refused")
+	}
+
+	result, err = limiter.Allow(ctx, key, limit)
+	if err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	if result.Allowed {
+		t.Fatal("second request allowed before the bucket refilled")
+	}
+	if result.RetryAfter <= 0 || result.RetryAfter > 100*time.Millisecond {
+		t.Fatalf("RetryAfter = %v, want at most 100ms", result.RetryAfter)
+	}
+
+	time.Sleep(result.RetryAfter + 10*time.Millisecond)
+
+	result, err = limiter.Allow(ctx, key, limit)
+	if err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	if !result.Allowed {
+		t.Fatal("request refused after the retry delay")
+	}
+}
+
+func TestAllowSeparateKeys(t *testing.T) {
+	limiter, key := newTestLimiter(t)
+	other := key + ":other"
+	t.Cleanup(func() { limiter.redis.Del(context.Background(), keyPrefix+other) })
+	limit := Limit{Rate: 1, Period: time.Hour, Burst: 1}
+	ctx := context.Background()
+
+	if result, err := limiter.Allow(ctx, key, limit); err != nil || !result.Allowed {
+		t.Fatalf("Allow(%q) = %+v, %v; want allowed", key, result, err)
+	}
+	if result, err := limiter.Allow(ctx, other, limit); err != nil || !result.Allowed {
+		t.Fatalf("Allow(%q) = %+v, %v; want allowed", other, result, err)
+	}
+}
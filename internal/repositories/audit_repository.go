@@ -0,0 +1,80 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lusoris/venio/internal/audit"
+	"github.com/lusoris/venio/internal/database"
+)
+
+// auditColumns is the number of columns Add writes per entry.
+const auditColumns = 13
+
+// AuditRepository persists the audit log.
+type AuditRepository struct {
+	db database.DBTX
+}
+
+// NewAuditRepository creates a new audit repository.
+func NewAuditRepository(db database.DBTX) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// Add stores a batch of entries in one statement. It implements
+// audit.Writer through audit.WriterFunc.
+func (r *AuditRepository) Add(ctx context.Context, entries []*audit.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var query strings.Builder
+	query.WriteString(`
+		INSERT INTO audit_log (occurred_at, action, resource, resource_id, actor, client_ip, method,
+			route, path, status, duration_ms, request_id, body)
+		VALUES `)
+
+	args := make([]any, 0, len(entries)*auditColumns)
+	for i, e := range entries {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteByte('(')
+		for col := range auditColumns {
+			if col > 0 {
+				query.WriteString(", ")
+			}
+			fmt.Fprintf(&query, "$%d", i*auditColumns+col+1)
+		}
+		query.WriteByte(')')
+
+		args = append(args,
+			e.Time, e.Action, e.Resource, e.ResourceID, e.Actor, e.ClientIP, e.Method,
+			e.Route, e.Path, e.Status, e.Latency.Milliseconds(), e.RequestID, e.Body,
+		)
+	}
+
+	if _, err := r.db.Exec(ctx, query.String(), args...); err != nil {
+		return fmt.Errorf("failed to store audit entries: %w", err)
+	}
+	return nil
+}
+
+// DeleteBefore removes entries recorded before cutoff and returns how many
+// were deleted.
+func (r *AuditRepository) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM audit_log WHERE occurred_at < $1`, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete audit entries: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
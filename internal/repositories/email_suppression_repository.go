@@ -0,0 +1,125 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/models"
+)
+
+// emailSuppressionColumns is the column list shared by suppression queries.
+const emailSuppressionColumns = `id, email, reason, provider, detail, created_at, updated_at`
+
+// EmailSuppressionRepository persists addresses that must not be sent
+// email. Addresses are stored in lower case.
+type EmailSuppressionRepository struct {
+	db database.DBTX
+	// read runs the listing queries; see WithReplica.
+	read database.DBTX
+}
+
+// NewEmailSuppressionRepository creates a new email suppression repository.
+func NewEmailSuppressionRepository(db database.DBTX) *EmailSuppressionRepository {
+	return &EmailSuppressionRepository{db: db, read: db}
+}
+
+// WithReplica returns a repository that runs its listing queries on
+// replica, typically a database.Replica.
+func (r *EmailSuppressionRepository) WithReplica(replica database.DBTX) *EmailSuppressionRepository {
+	return &EmailSuppressionRepository{db: r.db, read: replica}
+}
+
+// Upsert suppresses an address, replacing the reason of an existing entry,
+// and fills in its ID and timestamps.
+func (r *EmailSuppressionRepository) Upsert(ctx context.Context, s *models.EmailSuppression) error {
+	query := `
+		INSERT INTO email_suppressions (email, reason, provider, detail)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (email) DO UPDATE
+		SET reason = EXCLUDED.reason, provider = EXCLUDED.provider, detail = EXCLUDED.detail, updated_at = NOW()
+		RETURNING id, created_at, updated_at`
+
+	s.Email = strings.ToLower(s.Email)
+	err := r.db.QueryRow(ctx, query, s.Email, s.Reason, s.Provider, s.Detail).
+		Scan(&s.ID, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to suppress email address: %w", err)
+	}
+	return nil
+}
+
+// IsSuppressed reports whether an address is suppressed.
+func (r *EmailSuppressionRepository) IsSuppressed(ctx context.Context, address string) (bool, error) {
+	var suppressed bool
+	err := r.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM email_suppressions WHERE email = $1)`,
+		strings.ToLower(address)).Scan(&suppressed)
+	if err != nil {
+		return false, fmt.Errorf("failed to check email suppression: %w", err)
+	}
+	return suppressed, nil
+}
+
+// List returns suppressions, newest first, optionally only those whose
+// address contains search.
+func (r *EmailSuppressionRepository) List(ctx context.Context, search string, limit, offset int) ([]*models.EmailSuppression, error) {
+	query := `
+		SELECT ` + emailSuppressionColumns + `
+		FROM email_suppressions
+		WHERE $1 = '' OR strpos(email, $1) > 0
+		ORDER BY updated_at DESC, id DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.read.Query(ctx, query, strings.ToLower(search), limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list email suppressions: %w", err)
+	}
+	defer rows.Close()
+
+	suppressions := []*models.EmailSuppression{}
+	for rows.Next() {
+		s, err := scanEmailSuppression(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan email suppression: %w", err)
+		}
+		suppressions = append(suppressions, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list email suppressions: %w", err)
+	}
+	return suppressions, nil
+}
+
+// Delete removes the suppression with the given ID, so the address is sent
+// email again.
+func (r *EmailSuppressionRepository) Delete(ctx context.Context, id int64) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM email_suppressions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete email suppression: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// scanEmailSuppression scans a single suppression row.
+func scanEmailSuppression(row pgx.Row) (*models.EmailSuppression, error) {
+	var s models.EmailSuppression
+	err := row.Scan(&s.ID, &s.Email, &s.Reason, &s.Provider, &s.Detail, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
@@ -0,0 +1,174 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/models"
+)
+
+// instanceColumns is the column list shared by instance queries.
+const instanceColumns = `id, name, type, url, api_key_encrypted, default_quality_profile_id,
+	default_root_folder, is_4k, is_default, enabled, created_at, updated_at`
+
+// InstanceRepository persists Arr and media server instances. The APIKey
+// field is stored as given; callers are responsible for encrypting it.
+type InstanceRepository struct {
+	db database.DBTX
+	// read runs the listing queries; see WithReplica.
+	read database.DBTX
+}
+
+// NewInstanceRepository creates a new instance repository.
+func NewInstanceRepository(db database.DBTX) *InstanceRepository {
+	return &InstanceRepository{db: db, read: db}
+}
+
+// WithReplica returns a repository that runs its listing queries on
+// replica, typically a database.Replica.
+func (r *InstanceRepository) WithReplica(replica database.DBTX) *InstanceRepository {
+	return &InstanceRepository{db: r.db, read: replica}
+}
+
+// WithTx returns a repository that runs its queries in tx.
+func (r *InstanceRepository) WithTx(tx pgx.Tx) *InstanceRepository {
+	return &InstanceRepository{db: tx, read: tx}
+}
+
+// Create inserts a new instance and fills in its ID and timestamps.
+func (r *InstanceRepository) Create(ctx context.Context, instance *models.Instance) error {
+	query := `
+		INSERT INTO instances (name, type, url, api_key_encrypted, default_quality_profile_id,
+			default_root_folder, is_4k, is_default, enabled)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		instance.Name, instance.Type, instance.URL, instance.APIKey, instance.DefaultQualityProfileID,
+		instance.DefaultRootFolder, instance.Is4K, instance.IsDefault, instance.Enabled,
+	).Scan(&instance.ID, &instance.CreatedAt, &instance.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicate
+		}
+		return fmt.Errorf("failed to create instance: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID returns the instance with the given ID.
+func (r *InstanceRepository) GetByID(ctx context.Context, id int64) (*models.Instance, error) {
+	query := `SELECT ` + instanceColumns + ` FROM instances WHERE id = $1`
+
+	instance, err := scanInstance(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get instance: %w", err)
+	}
+
+	return instance, nil
+}
+
+// List returns all instances ordered by type and name.
+func (r *InstanceRepository) List(ctx context.Context) ([]*models.Instance, error) {
+	query := `SELECT ` + instanceColumns + ` FROM instances ORDER BY type, name`
+
+	rows, err := r.read.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+	defer rows.Close()
+
+	var instances []*models.Instance
+	for rows.Next() {
+		instance, err := scanInstance(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan instance: %w", err)
+		}
+		instances = append(instances, instance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	return instances, nil
+}
+
+// Update saves all mutable fields of an existing instance.
+func (r *InstanceRepository) Update(ctx context.Context, instance *models.Instance) error {
+	query := `
+		UPDATE instances
+		SET name = $2, url = $3, api_key_encrypted = $4, default_quality_profile_id = $5,
+			default_root_folder = $6, is_4k = $7, is_default = $8, enabled = $9, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		instance.ID, instance.Name, instance.URL, instance.APIKey, instance.DefaultQualityProfileID,
+		instance.DefaultRootFolder, instance.Is4K, instance.IsDefault, instance.Enabled,
+	).Scan(&instance.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			return ErrDuplicate
+		}
+		return fmt.Errorf("failed to update instance: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the instance with the given ID.
+func (r *InstanceRepository) Delete(ctx context.Context, id int64) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM instances WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete instance: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ClearDefault unsets the default flag on every instance of the given type
+// and 4K tier, so that a new default can be chosen.
+func (r *InstanceRepository) ClearDefault(ctx context.Context, instanceType models.InstanceType, is4K bool) error {
+	query := `UPDATE instances SET is_default = FALSE, updated_at = NOW()
+		WHERE type = $1 AND is_4k = $2 AND is_default`
+
+	if _, err := r.db.Exec(ctx, query, instanceType, is4K); err != nil {
+		return fmt.Errorf("failed to clear default instance: %w", err)
+	}
+	return nil
+}
+
+// scanInstance scans a single instance row.
+func scanInstance(row pgx.Row) (*models.Instance, error) {
+	var instance models.Instance
+	err := row.Scan(
+		&instance.ID, &instance.Name, &instance.Type, &instance.URL, &instance.APIKey,
+		&instance.DefaultQualityProfileID, &instance.DefaultRootFolder, &instance.Is4K,
+		&instance.IsDefault, &instance.Enabled, &instance.CreatedAt, &instance.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &instance, nil
+}
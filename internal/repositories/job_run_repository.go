@@ -0,0 +1,97 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/models"
+)
+
+// JobRunRepository persists the execution history of background tasks.
+type JobRunRepository struct {
+	db database.DBTX
+	// read runs the listing queries; see WithReplica.
+	read database.DBTX
+}
+
+// NewJobRunRepository creates a new job run repository.
+func NewJobRunRepository(db database.DBTX) *JobRunRepository {
+	return &JobRunRepository{db: db, read: db}
+}
+
+// WithReplica returns a repository that runs its listing queries on
+// replica, typically a database.Replica.
+func (r *JobRunRepository) WithReplica(replica database.DBTX) *JobRunRepository {
+	return &JobRunRepository{db: r.db, read: replica}
+}
+
+// Add records a finished task execution and fills in its ID.
+func (r *JobRunRepository) Add(ctx context.Context, run *models.JobRun) error {
+	query := `
+		INSERT INTO job_runs (task_id, task_type, queue, status, attempt, duration_ms, error,
+			payload_digest, started_at, finished_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id`
+
+	err := r.db.QueryRow(ctx, query,
+		run.TaskID, run.Type, run.Queue, run.Status, run.Attempt, run.DurationMS, run.Error,
+		run.PayloadDigest, run.StartedAt, run.FinishedAt,
+	).Scan(&run.ID)
+	if err != nil {
+		return fmt.Errorf("failed to record job run: %w", err)
+	}
+	return nil
+}
+
+// List returns runs matching filter, most recent first.
+func (r *JobRunRepository) List(ctx context.Context, filter models.JobRunFilter, limit, offset int) ([]*models.JobRun, error) {
+	query := `
+		SELECT id, task_id, task_type, queue, status, attempt, duration_ms, error, payload_digest,
+			started_at, finished_at
+		FROM job_runs
+		WHERE ($1 = '' OR task_type = $1)
+			AND ($2 = '' OR status = $2)
+			AND finished_at >= $3
+		ORDER BY finished_at DESC, id DESC
+		LIMIT $4 OFFSET $5`
+
+	rows, err := r.read.Query(ctx, query, filter.Type, string(filter.Status), filter.Since, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job runs: %w", err)
+	}
+	defer rows.Close()
+
+	runs := []*models.JobRun{}
+	for rows.Next() {
+		var run models.JobRun
+		if err := rows.Scan(&run.ID, &run.TaskID, &run.Type, &run.Queue, &run.Status, &run.Attempt,
+			&run.DurationMS, &run.Error, &run.PayloadDigest, &run.StartedAt, &run.FinishedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan job run: %w", err)
+		}
+		runs = append(runs, &run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list job runs: %w", err)
+	}
+	return runs, nil
+}
+
+// DeleteBefore removes runs that finished before cutoff and returns how
+// many were deleted.
+func (r *JobRunRepository) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM job_runs WHERE finished_at < $1`, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete job runs: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
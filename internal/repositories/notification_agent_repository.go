@@ -0,0 +1,148 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/models"
+)
+
+// notificationAgentColumns is the column list shared by agent queries.
+const notificationAgentColumns = `id, name, type, settings_encrypted, events, locale, enabled, created_at,
+	updated_at`
+
+// NotificationAgentRepository persists notification agents. Settings are
+// stored as the ciphertext produced by the caller.
+type NotificationAgentRepository struct {
+	db database.DBTX
+	// read runs the listing queries; see WithReplica.
+	read database.DBTX
+}
+
+// NewNotificationAgentRepository creates a new notification agent repository.
+func NewNotificationAgentRepository(db database.DBTX) *NotificationAgentRepository {
+	return &NotificationAgentRepository{db: db, read: db}
+}
+
+// WithReplica returns a repository that runs its listing queries on
+// replica, typically a database.Replica.
+func (r *NotificationAgentRepository) WithReplica(replica database.DBTX) *NotificationAgentRepository {
+	return &NotificationAgentRepository{db: r.db, read: replica}
+}
+
+// Create inserts a new agent with encrypted settings and fills in its ID
+// and timestamps.
+func (r *NotificationAgentRepository) Create(ctx context.Context, agent *models.NotificationAgent, settings string) error {
+	query := `
+		INSERT INTO notification_agents (name, type, settings_encrypted, events, locale, enabled)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query, agent.Name, agent.Type, settings, agent.Events, agent.Locale, agent.Enabled).
+		Scan(&agent.ID, &agent.CreatedAt, &agent.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicate
+		}
+		return fmt.Errorf("failed to create notification agent: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns the agent with the given ID and its encrypted settings.
+func (r *NotificationAgentRepository) GetByID(ctx context.Context, id int64) (*models.NotificationAgent, string, error) {
+	query := `SELECT ` + notificationAgentColumns + ` FROM notification_agents WHERE id = $1`
+
+	agent, settings, err := scanNotificationAgent(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, "", ErrNotFound
+		}
+		return nil, "", fmt.Errorf("failed to get notification agent: %w", err)
+	}
+	return agent, settings, nil
+}
+
+// List returns all agents ordered by name, without their settings.
+func (r *NotificationAgentRepository) List(ctx context.Context) ([]*models.NotificationAgent, error) {
+	query := `SELECT ` + notificationAgentColumns + ` FROM notification_agents ORDER BY name`
+
+	rows, err := r.read.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification agents: %w", err)
+	}
+	defer rows.Close()
+
+	agents := []*models.NotificationAgent{}
+	for rows.Next() {
+		agent, _, err := scanNotificationAgent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification agent: %w", err)
+		}
+		agents = append(agents, agent)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list notification agents: %w", err)
+	}
+	return agents, nil
+}
+
+// Update saves all mutable fields of an existing agent.
+func (r *NotificationAgentRepository) Update(ctx context.Context, agent *models.NotificationAgent, settings string) error {
+	query := `
+		UPDATE notification_agents
+		SET name = $2, settings_encrypted = $3, events = $4, locale = $5, enabled = $6, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at`
+
+	err := r.db.QueryRow(ctx, query, agent.ID, agent.Name, settings, agent.Events, agent.Locale, agent.Enabled).
+		Scan(&agent.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			return ErrDuplicate
+		}
+		return fmt.Errorf("failed to update notification agent: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the agent with the given ID.
+func (r *NotificationAgentRepository) Delete(ctx context.Context, id int64) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM notification_agents WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete notification agent: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// scanNotificationAgent scans a single agent row and its encrypted settings.
+func scanNotificationAgent(row pgx.Row) (*models.NotificationAgent, string, error) {
+	var (
+		agent    models.NotificationAgent
+		settings string
+	)
+	err := row.Scan(&agent.ID, &agent.Name, &agent.Type, &settings, &agent.Events, &agent.Locale, &agent.Enabled,
+		&agent.CreatedAt, &agent.UpdatedAt)
+	if err != nil {
+		return nil, "", err
+	}
+	return &agent, settings, nil
+}
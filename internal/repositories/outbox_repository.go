@@ -0,0 +1,166 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/events"
+)
+
+// OutboxRepository stores domain events in the outbox table. Add must be
+// called on a transaction-bound repository (see WithTx) so the event commits
+// together with the state change it describes.
+type OutboxRepository struct {
+	db database.DBTX
+}
+
+// NewOutboxRepository creates a new outbox repository.
+func NewOutboxRepository(db database.DBTX) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// WithTx returns a repository that runs its queries in tx.
+func (r *OutboxRepository) WithTx(tx pgx.Tx) *OutboxRepository {
+	return &OutboxRepository{db: tx}
+}
+
+// Add records an event.
+func (r *OutboxRepository) Add(ctx context.Context, event *events.Event) error {
+	query := `
+		INSERT INTO outbox_events (event_type, aggregate_type, aggregate_id, payload)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at`
+
+	err := r.db.QueryRow(ctx, query, event.Type, event.AggregateType, event.AggregateID, event.Payload).
+		Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add outbox event: %w", err)
+	}
+	return nil
+}
+
+// PendingEvent is an outbox event that has not been published yet.
+type PendingEvent struct {
+	*events.Event
+	// Attempts counts the failed deliveries so far.
+	Attempts int
+	// DeliveredTo names the handlers that have already processed the
+	// event, which are not run again.
+	DeliveredTo []string
+}
+
+// LockPending returns up to limit unpublished events with fewer than
+// maxAttempts delivery attempts that are due, in insertion order, and locks
+// them until the surrounding transaction ends. Rows locked by another relay
+// are skipped, so several relays can run concurrently.
+func (r *OutboxRepository) LockPending(ctx context.Context, limit, maxAttempts int) ([]*PendingEvent, error) {
+	query := `
+		SELECT id, event_type, aggregate_type, aggregate_id, payload, created_at, attempts, delivered_to
+		FROM outbox_events
+		WHERE published_at IS NULL AND attempts < $2 AND next_attempt_at <= NOW()
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`
+
+	rows, err := r.db.Query(ctx, query, limit, maxAttempts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	var pending []*PendingEvent
+	for rows.Next() {
+		event := PendingEvent{Event: &events.Event{}}
+		if err := rows.Scan(&event.ID, &event.Type, &event.AggregateType, &event.AggregateID,
+			&event.Payload, &event.CreatedAt, &event.Attempts, &event.DeliveredTo); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		pending = append(pending, &event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to fetch outbox events: %w", err)
+	}
+	return pending, nil
+}
+
+// ListPublishedAfter returns up to limit published events with an ID
+// greater than afterID, in ID order.
+func (r *OutboxRepository) ListPublishedAfter(ctx context.Context, afterID int64, limit int) ([]*events.Event, error) {
+	query := `
+		SELECT id, event_type, aggregate_type, aggregate_id, payload, created_at
+		FROM outbox_events
+		WHERE id > $1 AND published_at IS NOT NULL
+		ORDER BY id
+		LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list published outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	published := []*events.Event{}
+	for rows.Next() {
+		var event events.Event
+		if err := rows.Scan(&event.ID, &event.Type, &event.AggregateType, &event.AggregateID,
+			&event.Payload, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		published = append(published, &event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list published outbox events: %w", err)
+	}
+	return published, nil
+}
+
+// MarkPublished records that an event was delivered.
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id int64) error {
+	_, err := r.db.Exec(ctx, `UPDATE outbox_events SET published_at = NOW(), attempts = attempts + 1 WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event published: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed records a failed delivery attempt, the handlers that have
+// processed the event so far, and when to try again.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, deliveredTo []string, nextAttempt time.Time, deliveryErr error) error {
+	query := `
+		UPDATE outbox_events
+		SET attempts = attempts + 1, last_error = $2, delivered_to = $3, next_attempt_at = $4
+		WHERE id = $1`
+
+	if deliveredTo == nil {
+		// A nil slice would be stored as NULL.
+		deliveredTo = []string{}
+	}
+	_, err := r.db.Exec(ctx, query, id, deliveryErr.Error(), deliveredTo, nextAttempt)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event failed: %w", err)
+	}
+	return nil
+}
+
+// DeletePublishedBefore removes events published before cutoff and returns
+// how many were deleted.
+func (r *OutboxRepository) DeletePublishedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM outbox_events WHERE published_at < $1`, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete published outbox events: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,291 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/models"
+)
+
+// webhookColumns is the column list shared by webhook queries.
+const webhookColumns = `id, name, url, secret_encrypted, events, enabled, consecutive_failures,
+	disabled_reason, created_at, updated_at`
+
+// webhookDeliveryColumns is the column list shared by delivery queries.
+const webhookDeliveryColumns = `id, webhook_id, event_id, event_type, attempt, success, status_code,
+	error, duration_ms, payload, created_at`
+
+// WebhookRepository persists outgoing webhooks and their delivery history.
+// The Secret field is stored as given; callers are responsible for
+// encrypting it.
+type WebhookRepository struct {
+	db database.DBTX
+	// read runs the listing queries; see WithReplica.
+	read database.DBTX
+}
+
+// NewWebhookRepository creates a new webhook repository.
+func NewWebhookRepository(db database.DBTX) *WebhookRepository {
+	return &WebhookRepository{db: db, read: db}
+}
+
+// WithReplica returns a repository that runs its listing queries on
+// replica, typically a database.Replica.
+func (r *WebhookRepository) WithReplica(replica database.DBTX) *WebhookRepository {
+	return &WebhookRepository{db: r.db, read: replica}
+}
+
+// WithTx returns a repository that runs its queries in tx.
+func (r *WebhookRepository) WithTx(tx pgx.Tx) *WebhookRepository {
+	return &WebhookRepository{db: tx, read: tx}
+}
+
+// Create inserts a new webhook and fills in its ID and timestamps.
+func (r *WebhookRepository) Create(ctx context.Context, webhook *models.Webhook) error {
+	query := `
+		INSERT INTO webhooks (name, url, secret_encrypted, events, enabled)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		webhook.Name, webhook.URL, webhook.Secret, webhook.Events, webhook.Enabled,
+	).Scan(&webhook.ID, &webhook.CreatedAt, &webhook.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicate
+		}
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID returns the webhook with the given ID.
+func (r *WebhookRepository) GetByID(ctx context.Context, id int64) (*models.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE id = $1`
+
+	webhook, err := scanWebhook(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// List returns all webhooks ordered by name.
+func (r *WebhookRepository) List(ctx context.Context) ([]*models.Webhook, error) {
+	return r.list(ctx, r.read, `SELECT `+webhookColumns+` FROM webhooks ORDER BY name`)
+}
+
+// ListEnabled returns the webhooks that currently receive deliveries.
+func (r *WebhookRepository) ListEnabled(ctx context.Context) ([]*models.Webhook, error) {
+	return r.list(ctx, r.db, `SELECT `+webhookColumns+` FROM webhooks WHERE enabled ORDER BY id`)
+}
+
+// Update saves all mutable fields of an existing webhook.
+func (r *WebhookRepository) Update(ctx context.Context, webhook *models.Webhook) error {
+	query := `
+		UPDATE webhooks
+		SET name = $2, url = $3, secret_encrypted = $4, events = $5, enabled = $6,
+			consecutive_failures = $7, disabled_reason = $8, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		webhook.ID, webhook.Name, webhook.URL, webhook.Secret, webhook.Events, webhook.Enabled,
+		webhook.ConsecutiveFailures, webhook.DisabledReason,
+	).Scan(&webhook.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			return ErrDuplicate
+		}
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the webhook with the given ID and its delivery history.
+func (r *WebhookRepository) Delete(ctx context.Context, id int64) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM webhooks WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// RecordSuccess resets the failure counter after a successful delivery.
+func (r *WebhookRepository) RecordSuccess(ctx context.Context, id int64) error {
+	_, err := r.db.Exec(ctx, `UPDATE webhooks SET consecutive_failures = 0 WHERE id = $1 AND consecutive_failures > 0`, id)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook success: %w", err)
+	}
+	return nil
+}
+
+// RecordFailure increments the failure counter of an enabled webhook and
+// disables it once the counter reaches maxFailures. It reports whether this
+// call disabled the webhook.
+func (r *WebhookRepository) RecordFailure(ctx context.Context, id int64, maxFailures int, reason string) (bool, error) {
+	query := `
+		UPDATE webhooks
+		SET consecutive_failures = consecutive_failures + 1,
+			enabled = consecutive_failures + 1 < $2,
+			disabled_reason = CASE WHEN consecutive_failures + 1 >= $2 THEN $3 ELSE '' END,
+			updated_at = NOW()
+		WHERE id = $1 AND enabled
+		RETURNING enabled`
+
+	var enabled bool
+	err := r.db.QueryRow(ctx, query, id, maxFailures, reason).Scan(&enabled)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Deleted or already disabled in the meantime.
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to record webhook failure: %w", err)
+	}
+	return !enabled, nil
+}
+
+// AddDelivery records a delivery attempt and fills in its ID and timestamp.
+func (r *WebhookRepository) AddDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	query := `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, attempt, success,
+			status_code, error, duration_ms, payload)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at`
+
+	err := r.db.QueryRow(ctx, query,
+		delivery.WebhookID, delivery.EventID, delivery.EventType, delivery.Attempt, delivery.Success,
+		delivery.StatusCode, delivery.Error, delivery.DurationMS, delivery.Payload,
+	).Scan(&delivery.ID, &delivery.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries returns the most recent delivery attempts of a webhook,
+// newest first.
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, webhookID int64, limit int) ([]*models.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE webhook_id = $1
+		ORDER BY id DESC
+		LIMIT $2`
+
+	rows, err := r.read.Query(ctx, query, webhookID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*models.WebhookDelivery{}
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// GetDelivery returns a single delivery attempt of a webhook.
+func (r *WebhookRepository) GetDelivery(ctx context.Context, webhookID, deliveryID int64) (*models.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries WHERE webhook_id = $1 AND id = $2`
+
+	delivery, err := scanWebhookDelivery(r.db.QueryRow(ctx, query, webhookID, deliveryID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	return delivery, nil
+}
+
+// DeleteDeliveriesBefore removes delivery attempts recorded before cutoff
+// and returns how many were deleted.
+func (r *WebhookRepository) DeleteDeliveriesBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM webhook_deliveries WHERE created_at < $1`, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// list runs a webhook query on db and scans every row.
+func (r *WebhookRepository) list(ctx context.Context, db database.DBTX, query string) ([]*models.Webhook, error) {
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	var webhooks []*models.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// scanWebhook scans a single webhook row.
+func scanWebhook(row pgx.Row) (*models.Webhook, error) {
+	var webhook models.Webhook
+	err := row.Scan(
+		&webhook.ID, &webhook.Name, &webhook.URL, &webhook.Secret, &webhook.Events, &webhook.Enabled,
+		&webhook.ConsecutiveFailures, &webhook.DisabledReason, &webhook.CreatedAt, &webhook.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// scanWebhookDelivery scans a single delivery row.
+func scanWebhookDelivery(row pgx.Row) (*models.WebhookDelivery, error) {
+	var d models.WebhookDelivery
+	err := row.Scan(&d.ID, &d.WebhookID, &d.EventID, &d.EventType, &d.Attempt, &d.Success,
+		&d.StatusCode, &d.Error, &d.DurationMS, &d.Payload, &d.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
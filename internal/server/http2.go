@@ -0,0 +1,48 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package server
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/lusoris/venio/internal/config"
+)
+
+// configureHTTP2 sets up the protocols srv speaks besides HTTP/1.1. Over
+// TLS, net/http negotiates HTTP/2 unless cfg.HTTP2 is off. Without TLS,
+// cfg.H2C serves HTTP/2 to clients that send its preface or ask to upgrade.
+func configureHTTP2(srv *http.Server, cfg config.ServerConfig) error {
+	if srv.TLSConfig != nil {
+		if !cfg.HTTP2 {
+			// A non-nil map keeps net/http from adding HTTP/2, and ACME
+			// must not offer it either.
+			srv.TLSNextProto = map[string]func(*http.Server, *tls.Conn, http.Handler){}
+			srv.TLSConfig.NextProtos = slices.DeleteFunc(srv.TLSConfig.NextProtos, func(p string) bool { return p == http2.NextProtoTLS })
+		}
+		return nil
+	}
+	if !cfg.H2C {
+		return nil
+	}
+
+	// Configuring the server makes Shutdown send GOAWAY on HTTP/2
+	// connections. It does not wait for them, since h2c hijacks them, but
+	// event streams on them still end when draining starts.
+	h2s := &http2.Server{}
+	if err := http2.ConfigureServer(srv, h2s); err != nil {
+		return fmt.Errorf("failed to configure h2c: %w", err)
+	}
+	srv.Handler = h2c.NewHandler(srv.Handler, h2s)
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/lusoris/venio/internal/config"
+)
+
+// listenFDsStart is the first file descriptor passed by systemd socket
+// activation; see sd_listen_fds(3).
+const listenFDsStart = 3
+
+// loopback is the address reported for connections over a Unix socket.
+var loopback = &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}
+
+// listen opens the server's listener: the one handed over by a graceful
+// restart, else the socket passed by systemd if the process was
+// socket-activated, else cfg.SocketPath if set, else cfg.Port.
+func listen(cfg config.ServerConfig) (net.Listener, error) {
+	ln, err := inheritedListener()
+	if err != nil {
+		return nil, err
+	}
+	if ln == nil {
+		if ln, err = activatedListener(); err != nil {
+			return nil, err
+		}
+	}
+	if ln == nil && cfg.SocketPath != "" {
+		ln, err = listenUnix(cfg.SocketPath, cfg.SocketFileMode())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if ln == nil {
+		if ln, err = net.Listen("tcp", cfg.Addr()); err != nil {
+			return nil, err
+		}
+	}
+
+	if ln.Addr().Network() == "unix" {
+		return unixListener{ln}, nil
+	}
+	return ln, nil
+}
+
+// activatedListener returns the socket passed by systemd, or nil if there
+// is none. Only the first socket is used.
+func activatedListener() (net.Listener, error) {
+	if os.Getenv("LISTEN_PID") != strconv.Itoa(os.Getpid()) {
+		return nil, nil
+	}
+	fds, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || fds < 1 {
+		return nil, nil
+	}
+	// Child processes must not take the sockets for their own.
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+	os.Unsetenv("LISTEN_FDNAMES")
+	if fds > 1 {
+		log.Printf("systemd passed %d sockets, using the first", fds)
+	}
+
+	file := os.NewFile(listenFDsStart, "systemd socket")
+	defer file.Close()
+	ln, err := net.FileListener(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to use systemd socket: %w", err)
+	}
+	return ln, nil
+}
+
+// listenUnix listens on a Unix socket at path with the given permissions,
+// replacing the socket left behind by a previous run.
+func listenUnix(path string, mode os.FileMode) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode().Type() != fs.ModeSocket {
+			return nil, fmt.Errorf("SERVER_SOCKET %s exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove stale socket: %w", err)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to check SERVER_SOCKET: %w", err)
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		ln.Close()
+		return nil, fmt.Errorf("failed to set socket permissions: %w", err)
+	}
+	return ln, nil
+}
+
+// unixListener reports connections over a Unix socket as coming from
+// 127.0.0.1 instead of an empty address. They come from a local reverse
+// proxy, which can then be listed in TRUSTED_PROXIES so the client IP is
+// taken from its header, as for a proxy on the loopback interface.
+type unixListener struct {
+	net.Listener
+}
+
+func (l unixListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return unixConn{conn}, nil
+}
+
+// unixConn is a Unix socket connection reporting the loopback address.
+type unixConn struct {
+	net.Conn
+}
+
+func (c unixConn) RemoteAddr() net.Addr {
+	return loopback
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package server
+
+import (
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/lusoris/venio/internal/config"
+)
+
+// serveOne accepts one connection on ln and writes the remote address the
+// server sees to it. It may run in its own goroutine.
+func serveOne(t *testing.T, ln net.Listener) {
+	t.Helper()
+
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Errorf("Accept() error = %v", err)
+		return
+	}
+	defer conn.Close()
+	if _, err := io.WriteString(conn, conn.RemoteAddr().String()); err != nil {
+		t.Error(err)
+	}
+}
+
+// dialAndRead connects to a listener and returns what the server wrote.
+func dialAndRead(t *testing.T, network, addr string) string {
+	t.Helper()
+
+	conn, err := net.Dial(network, addr)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer conn.Close()
+	got, err := io.ReadAll(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(got)
+}
+
+func TestListenUnix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "venio.sock")
+	cfg := config.ServerConfig{SocketPath: path, SocketMode: "0600"}
+
+	ln, err := listen(cfg)
+	if err != nil {
+		t.Fatalf("listen() error = %v", err)
+	}
+	if _, ok := ln.(unixListener); !ok {
+		t.Errorf("listen() = %T, want unixListener", ln)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("socket permissions = %v, want 0600", perm)
+	}
+
+	go serveOne(t, ln)
+	if got := dialAndRead(t, "unix", path); got != "127.0.0.1:0" {
+		t.Errorf("remote address = %q, want the loopback address", got)
+	}
+
+	// A socket left behind by a crash is replaced.
+	ln.(unixListener).Listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	ln.Close()
+	if _, err := os.Lstat(path); err != nil {
+		t.Fatalf("socket gone after close: %v", err)
+	}
+	ln, err = listen(cfg)
+	if err != nil {
+		t.Fatalf("listen() over a stale socket error = %v", err)
+	}
+	ln.Close()
+}
+
+func TestListenUnixRefusesOtherFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "venio.sock")
+	if err := os.WriteFile(path, []byte("data"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := listen(config.ServerConfig{SocketPath: path, SocketMode: "0660"})
+	if err == nil || !strings.Contains(err.Error(), "is not a socket") {
+		t.Fatalf("listen() error = %v, want a refusal to replace the file", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "data" {
+		t.Error("listen() changed the file")
+	}
+}
+
+func TestActivatedListenerIgnoresOtherProcesses(t *testing.T) {
+	t.Setenv("LISTEN_PID", strconv.Itoa(os.Getppid()))
+	t.Setenv("LISTEN_FDS", "1")
+
+	ln, err := activatedListener()
+	if ln != nil || err != nil {
+		t.Fatalf("activatedListener() = %v, %v; want nothing for sockets passed to another process", ln, err)
+	}
+}
+
+// TestActivatedListener runs the test binary with a listener as file
+// descriptor 3, as systemd passes it. The child takes the place of the
+// server and answers one connection on the socket it was passed.
+func TestActivatedListener(t *testing.T) {
+	if os.Getenv("VENIO_TEST_ACTIVATED") != "" {
+		// systemd sets LISTEN_PID to the pid of the process it starts,
+		// which only the child knows.
+		os.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()))
+		ln, err := listen(config.ServerConfig{SocketPath: filepath.Join(t.TempDir(), "unused.sock"), SocketMode: "0660"})
+		if err != nil {
+			t.Fatalf("listen() error = %v", err)
+		}
+		for _, key := range []string{"LISTEN_PID", "LISTEN_FDS", "LISTEN_FDNAMES"} {
+			if value, ok := os.LookupEnv(key); ok {
+				t.Errorf("%s = %q after activation, want it unset", key, value)
+			}
+		}
+		serveOne(t, ln)
+		return
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	file, err := ln.(*net.TCPListener).File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestActivatedListener$")
+	cmd.Env = append(os.Environ(), "VENIO_TEST_ACTIVATED=1", "LISTEN_FDS=1", "LISTEN_FDNAMES=venio")
+	cmd.ExtraFiles = []*os.File{file}
+	var out strings.Builder
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	// Only the child may accept connections.
+	ln.Close()
+
+	got := dialAndRead(t, "tcp", ln.Addr().String())
+	if err := cmd.Wait(); err != nil {
+		t.Fatalf("activated process failed: %v\n%s", err, out.String())
+	}
+	if !strings.HasPrefix(got, "127.0.0.1:") {
+		t.Errorf("activated process answered %q, want the client address", got)
+	}
+}
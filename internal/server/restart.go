@@ -0,0 +1,113 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"os/exec"
+)
+
+// restartEnv marks a process started by a graceful restart. It inherits
+// the listener as file descriptor 3 and reports that it is serving by
+// writing to file descriptor 4.
+const (
+	restartEnv     = "VENIO_RESTART"
+	restartReadyFD = 4
+)
+
+// inheritedListener returns the listener handed over by the process that
+// started this one in a graceful restart, or nil if there is none.
+func inheritedListener() (net.Listener, error) {
+	if os.Getenv(restartEnv) == "" {
+		return nil, nil
+	}
+
+	file := os.NewFile(listenFDsStart, "inherited listener")
+	defer file.Close()
+	ln, err := net.FileListener(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to use inherited listener: %w", err)
+	}
+	return ln, nil
+}
+
+// notifyRestarted tells the process that started this one that it is
+// serving, so that process can shut down.
+func notifyRestarted() {
+	if os.Getenv(restartEnv) == "" {
+		return
+	}
+	os.Unsetenv(restartEnv)
+
+	ready := os.NewFile(restartReadyFD, "restart ready")
+	defer ready.Close()
+	if _, err := ready.Write([]byte{1}); err != nil {
+		log.Printf("Failed to report restart: %v", err)
+	}
+}
+
+// restart starts the binary anew with the same arguments and environment,
+// handing it ln, and returns once it serves. It fails if the new process
+// exits first, e.g. on invalid configuration, in which case this process
+// keeps serving.
+func restart(ln net.Listener) error {
+	if u, ok := ln.(unixListener); ok {
+		ln = u.Listener
+	}
+	filer, ok := ln.(interface{ File() (*os.File, error) })
+	if !ok {
+		return fmt.Errorf("cannot hand over %s listener", ln.Addr().Network())
+	}
+	// The new process serves on the socket after this one closes it.
+	if u, ok := ln.(*net.UnixListener); ok {
+		u.SetUnlinkOnClose(false)
+	}
+	file, err := filer.File()
+	if err != nil {
+		return fmt.Errorf("failed to hand over listener: %w", err)
+	}
+	defer file.Close()
+
+	ready, readyWriter, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	defer ready.Close()
+
+	exe, err := os.Executable()
+	if err != nil {
+		readyWriter.Close()
+		return err
+	}
+	cmd := exec.Command(exe, os.Args[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), restartEnv+"=1")
+	cmd.ExtraFiles = []*os.File{file, readyWriter}
+	err = cmd.Start()
+	readyWriter.Close()
+	if err != nil {
+		return fmt.Errorf("failed to start new process: %w", err)
+	}
+
+	// The pipe closes without a write when the new process exits.
+	if _, err := ready.Read(make([]byte, 1)); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = errors.New("new process exited before serving")
+		}
+		go cmd.Wait()
+		return err
+	}
+	log.Printf("New process %d is serving", cmd.Process.Pid)
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package server
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lusoris/venio/internal/config"
+)
+
+// TestRestart restarts the test binary, which takes the place of the new
+// server process: it picks up the inherited listener, reports that it
+// serves, and answers one connection.
+func TestRestart(t *testing.T) {
+	if os.Getenv(restartEnv) != "" {
+		if os.Getenv("VENIO_TEST_RESTART_FAIL") != "" {
+			os.Exit(1)
+		}
+		ln, err := listen(config.ServerConfig{SocketPath: filepath.Join(t.TempDir(), "unused.sock"), SocketMode: "0660"})
+		if err != nil {
+			t.Fatalf("listen() error = %v", err)
+		}
+		notifyRestarted()
+		if _, ok := os.LookupEnv(restartEnv); ok {
+			t.Errorf("%s is still set after notifyRestarted()", restartEnv)
+		}
+		serveOne(t, ln)
+		return
+	}
+
+	args := os.Args
+	os.Args = []string{args[0], "-test.run=^TestRestart$"}
+	t.Cleanup(func() { os.Args = args })
+
+	t.Run("tcp", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+
+		if err := restart(ln); err != nil {
+			t.Fatalf("restart() error = %v", err)
+		}
+		ln.Close()
+
+		if got := dialAndRead(t, "tcp", ln.Addr().String()); !strings.HasPrefix(got, "127.0.0.1:") {
+			t.Errorf("new process answered %q, want the client address", got)
+		}
+	})
+
+	t.Run("unix", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "venio.sock")
+		ln, err := listen(config.ServerConfig{SocketPath: path, SocketMode: "0660"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+
+		if err := restart(ln); err != nil {
+			t.Fatalf("restart() error = %v", err)
+		}
+		// Closing the old listener must leave the socket to the new process.
+		ln.Close()
+
+		if got := dialAndRead(t, "unix", path); got != "127.0.0.1:0" {
+			t.Errorf("new process answered %q, want the loopback address", got)
+		}
+	})
+
+	t.Run("new process exits", func(t *testing.T) {
+		t.Setenv("VENIO_TEST_RESTART_FAIL", "1")
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+
+		err = restart(ln)
+		if err == nil || err.Error() != "new process exited before serving" {
+			t.Fatalf("restart() error = %v, want the new process to have exited", err)
+		}
+
+		// This process keeps serving.
+		go serveOne(t, ln)
+		if got := dialAndRead(t, "tcp", ln.Addr().String()); !strings.HasPrefix(got, "127.0.0.1:") {
+			t.Errorf("old process answered %q, want the client address", got)
+		}
+	})
+}
+
+func TestRestartRefusesUnsupportedListeners(t *testing.T) {
+	ln := unixListener{fakeListener{}}
+	if err := restart(ln); err == nil || !strings.Contains(err.Error(), "cannot hand over") {
+		t.Errorf("restart() error = %v, want a refusal", err)
+	}
+}
+
+// fakeListener is a listener that cannot be handed to another process.
+type fakeListener struct {
+	net.Listener
+}
+
+func (fakeListener) Addr() net.Addr {
+	return &net.UnixAddr{Name: "fake", Net: "unix"}
+}
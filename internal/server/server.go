@@ -0,0 +1,139 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package server runs the HTTP server and shuts it down gracefully.
+package server
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/lusoris/venio/internal/config"
+)
+
+// drainKey is the context key of the channel closed on shutdown.
+type drainKey struct{}
+
+// Draining returns a channel that is closed when the server starts to shut
+// down. Handlers of long-lived connections such as event streams select on
+// it to end them, since shutdown would otherwise wait for them until the
+// timeout. Outside of Run the channel is nil and never ready.
+func Draining(ctx context.Context) <-chan struct{} {
+	ch, _ := ctx.Value(drainKey{}).(chan struct{})
+	return ch
+}
+
+// ClearWriteDeadline lifts the server's write timeout for a response that
+// stays open, such as an event stream.
+func ClearWriteDeadline(w http.ResponseWriter) {
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		log.Printf("Failed to clear write deadline: %v", err)
+	}
+}
+
+// Run serves handler, over HTTPS when tlsCfg is enabled, until SIGINT or
+// SIGTERM. It then stops accepting connections, ends event streams, and
+// waits up to cfg.ShutdownTimeout for in-flight requests. Requests still
+// running at the timeout are aborted.
+//
+// It listens on the socket passed by systemd socket activation, on
+// cfg.SocketPath, or on cfg.Port, in that order. With cfg.GracefulRestart,
+// SIGHUP starts the binary anew on the same listener and shuts this
+// process down the same way once the new one serves.
+func Run(cfg config.ServerConfig, tlsCfg config.TLSConfig, handler http.Handler) error {
+	draining := make(chan struct{})
+	srv := &http.Server{
+		Handler:           handler,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+		MaxHeaderBytes:    cfg.MaxHeaderBytes,
+		BaseContext: func(net.Listener) context.Context {
+			return context.WithValue(context.Background(), drainKey{}, draining)
+		},
+	}
+	srv.RegisterOnShutdown(func() { close(draining) })
+
+	if tlsCfg.Enabled() {
+		tlsConfig, err := newTLSConfig(tlsCfg)
+		if err != nil {
+			return err
+		}
+		srv.TLSConfig = tlsConfig
+	}
+	if err := configureHTTP2(srv, cfg); err != nil {
+		return err
+	}
+
+	ln, err := listen(cfg)
+	if err != nil {
+		return err
+	}
+	log.Printf("Listening on %s %s", ln.Addr().Network(), ln.Addr())
+
+	serve := func() error { return srv.Serve(ln) }
+	if srv.TLSConfig != nil {
+		serve = func() error { return srv.ServeTLS(ln, "", "") }
+	}
+
+	errs := make(chan error, 1)
+	go func() { errs <- serve() }()
+	notifyRestarted()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	if cfg.GracefulRestart {
+		signal.Notify(sigs, syscall.SIGHUP)
+	}
+	defer signal.Stop(sigs)
+
+	restarted := make(chan error, 1)
+	restarting := false
+wait:
+	for {
+		select {
+		case err := <-errs:
+			return err
+		case sig := <-sigs:
+			if sig != syscall.SIGHUP {
+				log.Printf("Received %s, draining server...", sig)
+				break wait
+			}
+			if restarting {
+				continue
+			}
+			log.Println("Received SIGHUP, restarting...")
+			restarting = true
+			go func() { restarted <- restart(ln) }()
+		case err := <-restarted:
+			restarting = false
+			if err != nil {
+				log.Printf("Restart failed, still serving: %v", err)
+				continue
+			}
+			log.Println("Restarted, draining server...")
+			break wait
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("Requests still running after %v, aborting them", cfg.ShutdownTimeout)
+		return srv.Close()
+	}
+
+	log.Println("Server drained")
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package server
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/lusoris/venio/internal/config"
+)
+
+// certCheckInterval is how often certificate files are checked for
+// changes, at most once per handshake.
+const certCheckInterval = 10 * time.Second
+
+// newTLSConfig builds the server's TLS configuration with certificates from
+// files or from ACME.
+func newTLSConfig(cfg config.TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:   cfg.MinTLSVersion(),
+		CipherSuites: cfg.CipherSuiteIDs(),
+	}
+
+	if len(cfg.ACMEDomains) > 0 {
+		// Certificates are validated with TLS-ALPN-01 on this listener,
+		// so no port 80 handler is needed.
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(cfg.ACMEDomains...),
+			Cache:      autocert.DirCache(cfg.ACMECacheDir),
+			Email:      cfg.ACMEEmail,
+		}
+		acme := manager.TLSConfig()
+		tlsConfig.GetCertificate = acme.GetCertificate
+		tlsConfig.NextProtos = acme.NextProtos
+		return tlsConfig, nil
+	}
+
+	reloader, err := newCertReloader(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.GetCertificate = reloader.getCertificate
+	return tlsConfig, nil
+}
+
+// certReloader serves a certificate from files and reloads it when either
+// file changes, so renewed certificates are picked up without a restart.
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	loaded  time.Time
+	checked time.Time
+}
+
+// newCertReloader loads the certificate, failing if it cannot be read.
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// getCertificate is the tls.Config.GetCertificate callback. A certificate
+// that fails to reload is logged and the previous one kept.
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checked) >= certCheckInterval {
+		r.checked = time.Now()
+		if r.changed() {
+			if err := r.load(); err != nil {
+				log.Printf("Failed to reload TLS certificate, keeping the previous one: %v", err)
+			} else {
+				log.Printf("Reloaded TLS certificate from %s", r.certFile)
+			}
+		}
+	}
+	return r.cert, nil
+}
+
+// changed reports whether a file was modified after the certificate was
+// loaded.
+func (r *certReloader) changed() bool {
+	for _, name := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(name)
+		if err == nil && info.ModTime().After(r.loaded) {
+			return true
+		}
+	}
+	return false
+}
+
+// load reads the key pair.
+func (r *certReloader) load() error {
+	loaded := time.Now()
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	r.cert, r.loaded, r.checked = &cert, loaded, loaded
+	return nil
+}
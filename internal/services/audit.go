@@ -0,0 +1,21 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package services
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/lusoris/venio/internal/audit"
+)
+
+// recordAudit records in the audit log that the actor in ctx performed
+// action, e.g. "instance.delete", on the resource with the given ID.
+func recordAudit(ctx context.Context, logger audit.Logger, action, resource string, id int64) {
+	logger.Log(ctx, audit.NewEntry(ctx, action, resource, strconv.FormatInt(id, 10)))
+}
@@ -0,0 +1,199 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/lusoris/venio/internal/audit"
+	"github.com/lusoris/venio/internal/email"
+	"github.com/lusoris/venio/internal/i18n"
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/repositories"
+)
+
+// Errors returned by EmailService.
+var (
+	ErrEmailTemplateNotFound    = errors.New("email template not found")
+	ErrInvalidEmailAddress      = errors.New("invalid email address")
+	ErrEmailSuppressionNotFound = errors.New("email suppression not found")
+	ErrUnknownFeedbackProvider  = errors.New("unknown email feedback provider")
+)
+
+// sampleEmailURL is the link used in previews.
+const sampleEmailURL = "https://venio.example.com/sample"
+
+// EmailService lets operators check the email setup: it renders templates
+// with sample data and sends test emails through the configured provider.
+// It also keeps the list of addresses that bounced or complained, which the
+// sender skips.
+type EmailService struct {
+	renderer     *email.Renderer
+	sender       email.Sender
+	bundle       *i18n.Bundle
+	suppressions *repositories.EmailSuppressionRepository
+	audit        audit.Logger
+	client       *http.Client
+}
+
+// NewEmailService creates a new email service.
+func NewEmailService(
+	renderer *email.Renderer,
+	sender email.Sender,
+	bundle *i18n.Bundle,
+	suppressions *repositories.EmailSuppressionRepository,
+	auditLogger audit.Logger,
+) *EmailService {
+	return &EmailService{
+		renderer:     renderer,
+		sender:       sender,
+		bundle:       bundle,
+		suppressions: suppressions,
+		audit:        auditLogger,
+		client:       &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Preview renders the named template with sample data in the given locale.
+func (s *EmailService) Preview(name, locale string) (*email.Message, error) {
+	l := s.bundle.Localizer(locale)
+
+	var data any
+	switch name {
+	case email.TemplateVerification:
+		data = email.VerificationData{Name: "Alex", URL: sampleEmailURL, ExpiresIn: 24 * time.Hour}
+	case email.TemplatePasswordReset:
+		data = email.PasswordResetData{Name: "Alex", URL: sampleEmailURL, ExpiresIn: time.Hour}
+	case email.TemplateNotification:
+		data = email.NotificationData{
+			Name:  "Alex",
+			Title: l.T("email.test.title"),
+			Body:  l.T("email.test.body"),
+			URL:   sampleEmailURL,
+		}
+	default:
+		return nil, ErrEmailTemplateNotFound
+	}
+
+	msg, err := s.renderer.Render(name, locale, "alex@example.com", data)
+	if errors.Is(err, email.ErrUnknownTemplate) {
+		return nil, ErrEmailTemplateNotFound
+	}
+	return msg, err
+}
+
+// SendTest sends a test email to the given address right away, bypassing
+// the queue, and returns the provider's error.
+func (s *EmailService) SendTest(ctx context.Context, to, locale string) error {
+	address, err := mail.ParseAddress(to)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEmailAddress, err)
+	}
+
+	name := address.Name
+	if name == "" {
+		name, _, _ = strings.Cut(address.Address, "@")
+	}
+
+	l := s.bundle.Localizer(locale)
+	msg, err := s.renderer.Render(email.TemplateNotification, locale, address.String(), email.NotificationData{
+		Name:  name,
+		Title: l.T("email.test.title"),
+		Body:  l.T("email.test.body"),
+	})
+	if err != nil {
+		return err
+	}
+	return s.sender.Send(ctx, msg)
+}
+
+// HandleFeedback records the bounces and complaints in a provider's
+// webhook request and returns how many addresses were suppressed. SES
+// subscription confirmations are confirmed right away.
+func (s *EmailService) HandleFeedback(ctx context.Context, provider string, body []byte) (int, error) {
+	var (
+		feedback []email.Feedback
+		err      error
+	)
+	switch provider {
+	case email.ProviderSES:
+		var subscribeURL string
+		feedback, subscribeURL, err = email.ParseSESFeedback(body)
+		if err == nil && subscribeURL != "" {
+			return 0, s.confirmSubscription(ctx, subscribeURL)
+		}
+	case email.ProviderSendGrid:
+		feedback, err = email.ParseSendGridFeedback(body)
+	default:
+		return 0, ErrUnknownFeedbackProvider
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	for _, f := range feedback {
+		suppression := &models.EmailSuppression{
+			Email:    f.Email,
+			Reason:   models.EmailSuppressionReason(f.Kind),
+			Provider: provider,
+			Detail:   f.Detail,
+		}
+		if err := s.suppressions.Upsert(ctx, suppression); err != nil {
+			return 0, err
+		}
+		// Addresses are personal data, so they stay out of the log.
+		log.Printf("Suppressed an email address after a %s reported by %s", f.Kind, provider)
+	}
+	return len(feedback), nil
+}
+
+// ListSuppressions returns a page of suppressed addresses, newest first,
+// optionally only those containing search.
+func (s *EmailService) ListSuppressions(ctx context.Context, search string, page, perPage int) ([]*models.EmailSuppression, error) {
+	return s.suppressions.List(ctx, search, perPage, (page-1)*perPage)
+}
+
+// DeleteSuppression lifts a suppression so the address is sent email again.
+func (s *EmailService) DeleteSuppression(ctx context.Context, id int64) error {
+	if err := s.suppressions.Delete(ctx, id); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrEmailSuppressionNotFound
+		}
+		return err
+	}
+	recordAudit(ctx, s.audit, "email_suppression.delete", "email_suppression", id)
+	return nil
+}
+
+// confirmSubscription confirms an SNS topic subscription by visiting its
+// subscribe URL. The request is bounded by ctx and, when the request
+// timeout is disabled, by the client's timeout.
+func (s *EmailService) confirmSubscription(ctx context.Context, subscribeURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, subscribeURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to confirm SNS subscription: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to confirm SNS subscription: status %d", resp.StatusCode)
+	}
+	log.Printf("Confirmed SNS subscription for SES feedback")
+	return nil
+}
@@ -0,0 +1,326 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package services contains Venio's business logic.
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/lusoris/venio/internal/audit"
+	"github.com/lusoris/venio/internal/cache"
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/encryption"
+	"github.com/lusoris/venio/internal/events"
+	"github.com/lusoris/venio/internal/jobs"
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/providers"
+	"github.com/lusoris/venio/internal/repositories"
+	"github.com/lusoris/venio/internal/tasks"
+)
+
+// Errors returned by InstanceService.
+var (
+	ErrInstanceNotFound    = errors.New("instance not found")
+	ErrInstanceExists      = errors.New("an instance with this name already exists")
+	ErrInvalidInstanceType = errors.New("invalid instance type")
+)
+
+// connectionTestTimeout bounds a single "test connection" probe, also when
+// the request timeout is disabled.
+const connectionTestTimeout = 10 * time.Second
+
+// InstanceService manages Arr and media server instances. API keys are
+// encrypted before they reach the repository and decrypted on the way out.
+// Every change records a domain event in the outbox in the same transaction
+// and an entry in the audit log.
+type InstanceService struct {
+	db         *pgxpool.Pool
+	repo       *repositories.InstanceRepository
+	outbox     *repositories.OutboxRepository
+	encryptor  *encryption.Encryptor
+	enqueuer   tasks.Enqueuer
+	jobs       *jobs.Store
+	responses  *cache.ResponseCache
+	audit      audit.Logger
+	httpClient *http.Client
+}
+
+// NewInstanceService creates a new instance service.
+func NewInstanceService(
+	db *pgxpool.Pool,
+	repo *repositories.InstanceRepository,
+	outbox *repositories.OutboxRepository,
+	encryptor *encryption.Encryptor,
+	enqueuer tasks.Enqueuer,
+	jobStore *jobs.Store,
+	responses *cache.ResponseCache,
+	auditLogger audit.Logger,
+) *InstanceService {
+	return &InstanceService{
+		db:         db,
+		repo:       repo,
+		outbox:     outbox,
+		encryptor:  encryptor,
+		enqueuer:   enqueuer,
+		jobs:       jobStore,
+		responses:  responses,
+		audit:      auditLogger,
+		httpClient: &http.Client{Timeout: connectionTestTimeout},
+	}
+}
+
+// Create stores a new instance.
+func (s *InstanceService) Create(ctx context.Context, req *models.CreateInstanceRequest) (*models.Instance, error) {
+	if !req.Type.Valid() {
+		return nil, ErrInvalidInstanceType
+	}
+
+	instance := &models.Instance{
+		Name:                    req.Name,
+		Type:                    req.Type,
+		URL:                     req.URL,
+		APIKey:                  req.APIKey,
+		DefaultQualityProfileID: req.DefaultQualityProfileID,
+		DefaultRootFolder:       req.DefaultRootFolder,
+		Is4K:                    req.Is4K,
+		IsDefault:               req.IsDefault,
+		Enabled:                 req.Enabled == nil || *req.Enabled,
+	}
+
+	if err := s.save(ctx, instance, true); err != nil {
+		return nil, err
+	}
+	s.refreshProfiles(ctx, instance)
+
+	return instance, nil
+}
+
+// Get returns a single instance with its API key decrypted.
+func (s *InstanceService) Get(ctx context.Context, id int64) (*models.Instance, error) {
+	instance, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrInstanceNotFound
+		}
+		return nil, err
+	}
+
+	if err := s.decrypt(instance); err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+// List returns all configured instances with their API keys decrypted.
+func (s *InstanceService) List(ctx context.Context) ([]*models.Instance, error) {
+	instances, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, instance := range instances {
+		if err := s.decrypt(instance); err != nil {
+			return nil, err
+		}
+	}
+
+	return instances, nil
+}
+
+// Update applies the non-nil fields of req to an existing instance.
+func (s *InstanceService) Update(ctx context.Context, id int64, req *models.UpdateInstanceRequest) (*models.Instance, error) {
+	instance, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		instance.Name = *req.Name
+	}
+	if req.URL != nil {
+		instance.URL = *req.URL
+	}
+	if req.APIKey != nil && *req.APIKey != "" {
+		instance.APIKey = *req.APIKey
+	}
+	if req.DefaultQualityProfileID != nil {
+		instance.DefaultQualityProfileID = req.DefaultQualityProfileID
+	}
+	if req.DefaultRootFolder != nil {
+		instance.DefaultRootFolder = *req.DefaultRootFolder
+	}
+	if req.Is4K != nil {
+		instance.Is4K = *req.Is4K
+	}
+	if req.IsDefault != nil {
+		instance.IsDefault = *req.IsDefault
+	}
+	if req.Enabled != nil {
+		instance.Enabled = *req.Enabled
+	}
+
+	if err := s.save(ctx, instance, false); err != nil {
+		return nil, err
+	}
+	s.refreshProfiles(ctx, instance)
+
+	return instance, nil
+}
+
+// Delete removes an instance.
+func (s *InstanceService) Delete(ctx context.Context, id int64) error {
+	event, err := events.New(events.InstanceDeleted, events.AggregateInstance, strconv.FormatInt(id, 10),
+		map[string]int64{"id": id})
+	if err != nil {
+		return err
+	}
+
+	err = database.WithTx(ctx, s.db, func(tx pgx.Tx) error {
+		if err := s.repo.WithTx(tx).Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.outbox.WithTx(tx).Add(ctx, event)
+	})
+	if errors.Is(err, repositories.ErrNotFound) {
+		return ErrInstanceNotFound
+	}
+	if err != nil {
+		return err
+	}
+	s.invalidateResponses(ctx)
+	recordAudit(ctx, s.audit, "instance.delete", "instance", id)
+	return nil
+}
+
+// TestConnection checks that a stored instance is reachable with its API key.
+// The probe is bounded by ctx and by connectionTestTimeout.
+func (s *InstanceService) TestConnection(ctx context.Context, id int64) error {
+	instance, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return providers.TestConnection(ctx, s.httpClient, instance.Type, instance.URL, instance.APIKey)
+}
+
+// RefreshAllProfiles starts a background refresh of the quality profiles of
+// every enabled Arr instance and returns the job tracking it.
+func (s *InstanceService) RefreshAllProfiles(ctx context.Context) (*jobs.Status, error) {
+	job, err := s.jobs.Create(ctx, tasks.TypeArrRefreshAllProfiles)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := tasks.NewArrRefreshAllProfilesTask(job.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enqueuer.Enqueue(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// refreshProfiles schedules a reload of the cached quality profiles of an
+// enabled Arr instance. Failures are logged; the cache also expires on its own.
+func (s *InstanceService) refreshProfiles(ctx context.Context, instance *models.Instance) {
+	if !instance.Type.IsArr() || !instance.Enabled {
+		return
+	}
+
+	task, err := tasks.NewArrRefreshProfilesTask(instance.ID)
+	if err == nil {
+		err = s.enqueuer.Enqueue(ctx, task)
+	}
+	if err != nil {
+		log.Printf("Failed to schedule profile refresh for instance %d: %v", instance.ID, err)
+	}
+}
+
+// save encrypts the API key and creates or updates the instance together
+// with its outbox event. The decrypted key is restored on the model afterwards.
+func (s *InstanceService) save(ctx context.Context, instance *models.Instance, create bool) error {
+	plainKey := instance.APIKey
+	encrypted, err := s.encryptor.Encrypt(plainKey)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt API key: %w", err)
+	}
+
+	eventType, action := events.InstanceUpdated, "instance.update"
+	if create {
+		eventType, action = events.InstanceCreated, "instance.create"
+	}
+
+	instance.APIKey = encrypted
+	err = database.WithTx(ctx, s.db, func(tx pgx.Tx) error {
+		repo := s.repo.WithTx(tx)
+
+		if instance.IsDefault {
+			if err := repo.ClearDefault(ctx, instance.Type, instance.Is4K); err != nil {
+				return err
+			}
+		}
+
+		if create {
+			if err := repo.Create(ctx, instance); err != nil {
+				return err
+			}
+		} else if err := repo.Update(ctx, instance); err != nil {
+			return err
+		}
+
+		// The API key is excluded from the instance's JSON encoding.
+		event, err := events.New(eventType, events.AggregateInstance, strconv.FormatInt(instance.ID, 10), instance)
+		if err != nil {
+			return err
+		}
+		return s.outbox.WithTx(tx).Add(ctx, event)
+	})
+	instance.APIKey = plainKey
+
+	switch {
+	case errors.Is(err, repositories.ErrDuplicate):
+		return ErrInstanceExists
+	case errors.Is(err, repositories.ErrNotFound):
+		return ErrInstanceNotFound
+	case err != nil:
+		return err
+	}
+	s.invalidateResponses(ctx)
+	recordAudit(ctx, s.audit, action, "instance", instance.ID)
+	return nil
+}
+
+// invalidateResponses drops cached instance lists after a change. A
+// failure is only logged; the entries expire on their own.
+func (s *InstanceService) invalidateResponses(ctx context.Context) {
+	if err := s.responses.Invalidate(ctx, cache.RouteInstances); err != nil {
+		log.Printf("Failed to invalidate cached instance lists: %v", err)
+	}
+}
+
+// decrypt replaces the stored ciphertext with the plaintext API key.
+func (s *InstanceService) decrypt(instance *models.Instance) error {
+	apiKey, err := s.encryptor.Decrypt(instance.APIKey)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt API key for instance %d: %w", instance.ID, err)
+	}
+	instance.APIKey = apiKey
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/jobs"
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/repositories"
+)
+
+// ErrJobNotFound is returned when a queue or task does not exist.
+var ErrJobNotFound = errors.New("job not found")
+
+// QueueStats summarizes the state of one task queue.
+type QueueStats struct {
+	Queue          string `json:"queue"`
+	Size           int    `json:"size"`
+	Pending        int    `json:"pending"`
+	Active         int    `json:"active"`
+	Scheduled      int    `json:"scheduled"`
+	Retry          int    `json:"retry"`
+	Dead           int    `json:"dead"`
+	ProcessedToday int    `json:"processed_today"`
+	FailedToday    int    `json:"failed_today"`
+	Paused         bool   `json:"paused"`
+}
+
+// DeadJob is a task that exhausted its retries.
+type DeadJob struct {
+	ID           string          `json:"id"`
+	Queue        string          `json:"queue"`
+	Type         string          `json:"type"`
+	Payload      json.RawMessage `json:"payload,omitempty"`
+	LastError    string          `json:"last_error"`
+	LastFailedAt time.Time       `json:"last_failed_at"`
+	Retried      int             `json:"retried"`
+	MaxRetry     int             `json:"max_retry"`
+}
+
+// JobService reports job statuses and history and inspects background task
+// queues and the dead-letter queue.
+type JobService struct {
+	inspector *asynq.Inspector
+	store     *jobs.Store
+	runs      *repositories.JobRunRepository
+}
+
+// NewJobService creates a new job service.
+func NewJobService(inspector *asynq.Inspector, store *jobs.Store, runs *repositories.JobRunRepository) *JobService {
+	return &JobService{inspector: inspector, store: store, runs: runs}
+}
+
+// GetStatus returns the status of a job started through the API.
+func (s *JobService) GetStatus(ctx context.Context, id string) (*jobs.Status, error) {
+	status, err := s.store.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, jobs.ErrNotFound) {
+			return nil, ErrJobNotFound
+		}
+		return nil, err
+	}
+	return status, nil
+}
+
+// ListHistory returns one page of finished task runs matching filter, most
+// recent first.
+func (s *JobService) ListHistory(ctx context.Context, filter models.JobRunFilter, page, perPage int) ([]*models.JobRun, error) {
+	return s.runs.List(ctx, filter, perPage, (page-1)*perPage)
+}
+
+// ListQueues returns statistics for every known queue.
+func (s *JobService) ListQueues() ([]QueueStats, error) {
+	queues, err := s.inspector.Queues()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	stats := make([]QueueStats, 0, len(queues))
+	for _, queue := range queues {
+		info, err := s.inspector.GetQueueInfo(queue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get queue %q: %w", queue, err)
+		}
+		stats = append(stats, QueueStats{
+			Queue:          info.Queue,
+			Size:           info.Size,
+			Pending:        info.Pending,
+			Active:         info.Active,
+			Scheduled:      info.Scheduled,
+			Retry:          info.Retry,
+			Dead:           info.Archived,
+			ProcessedToday: info.Processed,
+			FailedToday:    info.Failed,
+			Paused:         info.Paused,
+		})
+	}
+
+	return stats, nil
+}
+
+// ListDead returns one page of dead jobs in queue.
+func (s *JobService) ListDead(queue string, page, perPage int) ([]DeadJob, error) {
+	infos, err := s.inspector.ListArchivedTasks(queue, asynq.Page(page), asynq.PageSize(perPage))
+	if err != nil {
+		if errors.Is(err, asynq.ErrQueueNotFound) {
+			return nil, ErrJobNotFound
+		}
+		return nil, fmt.Errorf("failed to list dead jobs: %w", err)
+	}
+
+	jobs := make([]DeadJob, 0, len(infos))
+	for _, info := range infos {
+		job := DeadJob{
+			ID:           info.ID,
+			Queue:        info.Queue,
+			Type:         info.Type,
+			LastError:    info.LastErr,
+			LastFailedAt: info.LastFailedAt,
+			Retried:      info.Retried,
+			MaxRetry:     info.MaxRetry,
+		}
+		if json.Valid(info.Payload) {
+			job.Payload = info.Payload
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// RequeueDead moves a dead job back to the pending state.
+func (s *JobService) RequeueDead(queue, id string) error {
+	if err := s.ensureDead(queue, id); err != nil {
+		return err
+	}
+	return s.wrapTaskErr(s.inspector.RunTask(queue, id))
+}
+
+// DeleteDead permanently removes a dead job.
+func (s *JobService) DeleteDead(queue, id string) error {
+	if err := s.ensureDead(queue, id); err != nil {
+		return err
+	}
+	return s.wrapTaskErr(s.inspector.DeleteTask(queue, id))
+}
+
+// ensureDead returns ErrJobNotFound unless the task exists and is archived.
+func (s *JobService) ensureDead(queue, id string) error {
+	info, err := s.inspector.GetTaskInfo(queue, id)
+	if err != nil {
+		return s.wrapTaskErr(err)
+	}
+	if info.State != asynq.TaskStateArchived {
+		return ErrJobNotFound
+	}
+	return nil
+}
+
+// wrapTaskErr maps inspector lookup errors to ErrJobNotFound.
+func (s *JobService) wrapTaskErr(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, asynq.ErrQueueNotFound), errors.Is(err, asynq.ErrTaskNotFound):
+		return ErrJobNotFound
+	default:
+		return fmt.Errorf("failed to update job: %w", err)
+	}
+}
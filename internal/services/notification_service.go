@@ -0,0 +1,319 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/audit"
+	"github.com/lusoris/venio/internal/encryption"
+	"github.com/lusoris/venio/internal/events"
+	"github.com/lusoris/venio/internal/i18n"
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/notifications"
+	"github.com/lusoris/venio/internal/repositories"
+	"github.com/lusoris/venio/internal/tasks"
+)
+
+// Errors returned by NotificationService.
+var (
+	ErrNotificationAgentNotFound = errors.New("notification agent not found")
+	ErrNotificationAgentExists   = errors.New("a notification agent with this name already exists")
+	ErrNotificationAgentDisabled = errors.New("notification agent is disabled")
+	ErrInvalidNotificationAgent  = errors.New("invalid notification agent")
+)
+
+// NotificationService manages notification agents and routes events to
+// them. Agent settings are encrypted before they reach the repository.
+// Changes to agents are recorded in the audit log.
+type NotificationService struct {
+	repo      *repositories.NotificationAgentRepository
+	encryptor *encryption.Encryptor
+	enqueuer  tasks.Enqueuer
+	bundle    *i18n.Bundle
+	throttle  *notifications.Throttle
+	audit     audit.Logger
+	client    *http.Client
+}
+
+// NewNotificationService creates a new notification service. Notification
+// text is translated with bundle, throttle suppresses repeated
+// notifications, and timeout bounds a single request to a notification
+// channel.
+func NewNotificationService(
+	repo *repositories.NotificationAgentRepository,
+	encryptor *encryption.Encryptor,
+	enqueuer tasks.Enqueuer,
+	bundle *i18n.Bundle,
+	throttle *notifications.Throttle,
+	auditLogger audit.Logger,
+	timeout time.Duration,
+) *NotificationService {
+	return &NotificationService{
+		repo:      repo,
+		encryptor: encryptor,
+		enqueuer:  enqueuer,
+		bundle:    bundle,
+		throttle:  throttle,
+		audit:     auditLogger,
+		client:    &http.Client{Timeout: timeout},
+	}
+}
+
+// Create validates and stores a new agent.
+func (s *NotificationService) Create(ctx context.Context, req *models.CreateNotificationAgentRequest) (*models.NotificationAgent, error) {
+	agent := &models.NotificationAgent{
+		Name:     req.Name,
+		Type:     req.Type,
+		Settings: req.Settings,
+		Events:   normalizeEvents(req.Events),
+		Locale:   req.Locale,
+		Enabled:  req.Enabled == nil || *req.Enabled,
+	}
+	if req.URL != "" {
+		if err := applyAppriseURL(agent, req.URL, req.Type); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.save(ctx, agent, true); err != nil {
+		return nil, err
+	}
+	return agent, nil
+}
+
+// Get returns a single agent with its settings decrypted.
+func (s *NotificationService) Get(ctx context.Context, id int64) (*models.NotificationAgent, error) {
+	agent, settings, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrNotificationAgentNotFound
+		}
+		return nil, err
+	}
+
+	if err := s.decrypt(agent, settings); err != nil {
+		return nil, err
+	}
+	return agent, nil
+}
+
+// List returns all agents. Settings are not loaded because they are never
+// serialized.
+func (s *NotificationService) List(ctx context.Context) ([]*models.NotificationAgent, error) {
+	return s.repo.List(ctx)
+}
+
+// Update applies the non-nil fields of req to an existing agent.
+func (s *NotificationService) Update(ctx context.Context, id int64, req *models.UpdateNotificationAgentRequest) (*models.NotificationAgent, error) {
+	agent, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		agent.Name = *req.Name
+	}
+	if req.Settings != nil {
+		agent.Settings = *req.Settings
+	}
+	if req.URL != nil {
+		if err := applyAppriseURL(agent, *req.URL, agent.Type); err != nil {
+			return nil, err
+		}
+	}
+	if req.Events != nil {
+		agent.Events = normalizeEvents(*req.Events)
+	}
+	if req.Locale != nil {
+		agent.Locale = *req.Locale
+	}
+	if req.Enabled != nil {
+		agent.Enabled = *req.Enabled
+	}
+
+	if err := s.save(ctx, agent, false); err != nil {
+		return nil, err
+	}
+	return agent, nil
+}
+
+// Delete removes an agent.
+func (s *NotificationService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrNotificationAgentNotFound
+		}
+		return err
+	}
+	recordAudit(ctx, s.audit, "notification_agent.delete", "notification_agent", id)
+	return nil
+}
+
+// Test sends a test notification through an agent right away, whether or
+// not it is enabled, and returns the channel's error.
+func (s *NotificationService) Test(ctx context.Context, id int64) error {
+	agent, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.send(ctx, agent, notifications.Test(s.bundle.Localizer(agent.Locale)))
+}
+
+// Fanout enqueues the notification for event, in each agent's locale, to
+// every enabled agent subscribed to its type. It is an events.Handler;
+// events without a notification are ignored. The task ID is derived from
+// the agent and event so a redelivered event is not announced twice, and
+// notifications about the same subject are throttled per agent.
+func (s *NotificationService) Fanout(ctx context.Context, event *events.Event) error {
+	agents, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	localized := make(map[string]*notifications.Notification)
+	var errs []error
+	for _, agent := range agents {
+		if !agent.Enabled || !agent.Subscribes(event.Type) {
+			continue
+		}
+
+		n, ok := localized[agent.Locale]
+		if !ok {
+			if n, ok = notifications.FromEvent(event, s.bundle.Localizer(agent.Locale)); !ok {
+				return nil
+			}
+			localized[agent.Locale] = n
+		}
+
+		allowed, err := s.throttle.Allow(ctx, agent.ID, n)
+		if err != nil {
+			// Better a duplicate than a lost notification.
+			log.Printf("Sending %s notification to agent %q unthrottled: %v", n.Event, agent.Name, err)
+		} else if !allowed {
+			continue
+		}
+
+		task, err := tasks.NewNotificationSendTask(agent.ID, n)
+		if err != nil {
+			return err
+		}
+		taskID := fmt.Sprintf("notification:%d:%d", agent.ID, event.ID)
+		if err := s.enqueuer.Enqueue(ctx, task, asynq.TaskID(taskID)); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			errs = append(errs, err)
+			// The event is retried, so let the retry through the throttle.
+			if err := s.throttle.Release(ctx, agent.ID, n); err != nil {
+				log.Printf("Agent %q stays throttled for %s: %v", agent.Name, n.Event, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Deliver sends a notification through an agent.
+func (s *NotificationService) Deliver(ctx context.Context, agentID int64, n *notifications.Notification) error {
+	agent, err := s.Get(ctx, agentID)
+	if err != nil {
+		return err
+	}
+	if !agent.Enabled {
+		return ErrNotificationAgentDisabled
+	}
+	return s.send(ctx, agent, n)
+}
+
+// send builds the agent's channel and sends n through it.
+func (s *NotificationService) send(ctx context.Context, agent *models.NotificationAgent, n *notifications.Notification) error {
+	channel, err := notifications.NewAgent(agent.Type, agent.Settings, s.client)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidNotificationAgent, err)
+	}
+	if err := channel.Send(ctx, n); err != nil {
+		return fmt.Errorf("notification agent %q: %w", agent.Name, err)
+	}
+	return nil
+}
+
+// save validates the agent, encrypts its settings, and creates or updates
+// it.
+func (s *NotificationService) save(ctx context.Context, agent *models.NotificationAgent, create bool) error {
+	if _, err := notifications.NewAgent(agent.Type, agent.Settings, s.client); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidNotificationAgent, err)
+	}
+	if agent.Locale != "" && !s.bundle.Supports(agent.Locale) {
+		return fmt.Errorf("%w: unsupported locale %q (supported: %s)", ErrInvalidNotificationAgent,
+			agent.Locale, strings.Join(s.bundle.Locales(), ", "))
+	}
+
+	plain, err := json.Marshal(agent.Settings)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification agent settings: %w", err)
+	}
+	encrypted, err := s.encryptor.Encrypt(string(plain))
+	if err != nil {
+		return fmt.Errorf("failed to encrypt notification agent settings: %w", err)
+	}
+
+	if create {
+		err = s.repo.Create(ctx, agent, encrypted)
+	} else {
+		err = s.repo.Update(ctx, agent, encrypted)
+	}
+
+	switch {
+	case errors.Is(err, repositories.ErrDuplicate):
+		return ErrNotificationAgentExists
+	case errors.Is(err, repositories.ErrNotFound):
+		return ErrNotificationAgentNotFound
+	case err != nil:
+		return err
+	}
+
+	action := "notification_agent.update"
+	if create {
+		action = "notification_agent.create"
+	}
+	recordAudit(ctx, s.audit, action, "notification_agent", agent.ID)
+	return nil
+}
+
+// applyAppriseURL sets the agent's type and settings from an Apprise URL.
+// When wantType is set, the URL must be of that type.
+func applyAppriseURL(agent *models.NotificationAgent, raw, wantType string) error {
+	agentType, settings, err := notifications.ParseAppriseURL(raw)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidNotificationAgent, err)
+	}
+	if wantType != "" && agentType != wantType {
+		return fmt.Errorf("%w: the URL is for a %s agent, not %s", ErrInvalidNotificationAgent, agentType, wantType)
+	}
+	agent.Type = agentType
+	agent.Settings = settings
+	return nil
+}
+
+// decrypt fills in the agent's settings from their stored ciphertext.
+func (s *NotificationService) decrypt(agent *models.NotificationAgent, settings string) error {
+	plain, err := s.encryptor.Decrypt(settings)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt settings for notification agent %d: %w", agent.ID, err)
+	}
+	if err := json.Unmarshal([]byte(plain), &agent.Settings); err != nil {
+		return fmt.Errorf("failed to decode settings for notification agent %d: %w", agent.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,368 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/lusoris/venio/internal/audit"
+	"github.com/lusoris/venio/internal/database"
+	"github.com/lusoris/venio/internal/encryption"
+	"github.com/lusoris/venio/internal/events"
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/repositories"
+	"github.com/lusoris/venio/internal/tasks"
+	"github.com/lusoris/venio/internal/webhooks"
+)
+
+// Errors returned by WebhookService.
+var (
+	ErrWebhookNotFound = errors.New("webhook not found")
+	ErrWebhookExists   = errors.New("a webhook with this name already exists")
+	ErrWebhookDisabled = errors.New("webhook is disabled")
+
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	// ErrWebhookPayloadMissing is returned when redelivering an attempt
+	// recorded before delivery payloads were kept.
+	ErrWebhookPayloadMissing = errors.New("the payload of this delivery was not recorded")
+)
+
+// WebhookService manages outgoing webhooks and delivers events to them.
+// Signing secrets are encrypted before they reach the repository. Changes,
+// including automatic disabling, are recorded in the audit log.
+type WebhookService struct {
+	db          *pgxpool.Pool
+	repo        *repositories.WebhookRepository
+	encryptor   *encryption.Encryptor
+	enqueuer    tasks.Enqueuer
+	sender      *webhooks.Sender
+	audit       audit.Logger
+	maxFailures int
+}
+
+// NewWebhookService creates a new webhook service. A webhook is disabled
+// after maxFailures consecutive failed delivery attempts.
+func NewWebhookService(
+	db *pgxpool.Pool,
+	repo *repositories.WebhookRepository,
+	encryptor *encryption.Encryptor,
+	enqueuer tasks.Enqueuer,
+	sender *webhooks.Sender,
+	auditLogger audit.Logger,
+	maxFailures int,
+) *WebhookService {
+	return &WebhookService{
+		db:          db,
+		repo:        repo,
+		encryptor:   encryptor,
+		enqueuer:    enqueuer,
+		sender:      sender,
+		audit:       auditLogger,
+		maxFailures: maxFailures,
+	}
+}
+
+// Create stores a new webhook.
+func (s *WebhookService) Create(ctx context.Context, req *models.CreateWebhookRequest) (*models.Webhook, error) {
+	webhook := &models.Webhook{
+		Name:    req.Name,
+		URL:     req.URL,
+		Secret:  req.Secret,
+		Events:  normalizeEvents(req.Events),
+		Enabled: req.Enabled == nil || *req.Enabled,
+	}
+
+	if err := s.save(ctx, webhook, true); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// Get returns a single webhook with its secret decrypted.
+func (s *WebhookService) Get(ctx context.Context, id int64) (*models.Webhook, error) {
+	webhook, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	if err := s.decrypt(webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// List returns all webhooks. Secrets are left encrypted because they are
+// never serialized.
+func (s *WebhookService) List(ctx context.Context) ([]*models.Webhook, error) {
+	return s.repo.List(ctx)
+}
+
+// Update applies the non-nil fields of req to an existing webhook.
+// Re-enabling a webhook clears its failure counter.
+func (s *WebhookService) Update(ctx context.Context, id int64, req *models.UpdateWebhookRequest) (*models.Webhook, error) {
+	webhook, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		webhook.Name = *req.Name
+	}
+	if req.URL != nil {
+		webhook.URL = *req.URL
+	}
+	if req.Secret != nil {
+		webhook.Secret = *req.Secret
+	}
+	if req.Events != nil {
+		webhook.Events = normalizeEvents(*req.Events)
+	}
+	if req.Enabled != nil {
+		if *req.Enabled && !webhook.Enabled {
+			webhook.ConsecutiveFailures = 0
+			webhook.DisabledReason = ""
+		}
+		webhook.Enabled = *req.Enabled
+	}
+
+	if err := s.save(ctx, webhook, false); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// Delete removes a webhook and its delivery history.
+func (s *WebhookService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrWebhookNotFound
+		}
+		return err
+	}
+	recordAudit(ctx, s.audit, "webhook.delete", "webhook", id)
+	return nil
+}
+
+// Deliveries returns the most recent delivery attempts of a webhook.
+func (s *WebhookService) Deliveries(ctx context.Context, id int64, limit int) ([]*models.WebhookDelivery, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return s.repo.ListDeliveries(ctx, id, limit)
+}
+
+// Fanout enqueues a delivery of event to every enabled webhook subscribed to
+// its type. It is an events.Handler; the task ID is derived from the webhook
+// and event so a redelivered event does not produce duplicate deliveries.
+func (s *WebhookService) Fanout(ctx context.Context, event *events.Event) error {
+	hooks, err := s.repo.ListEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, webhook := range hooks {
+		if !webhook.Subscribes(event.Type) {
+			continue
+		}
+
+		task, err := tasks.NewWebhookDeliverTask(webhook.ID, event)
+		if err != nil {
+			return err
+		}
+		taskID := fmt.Sprintf("webhook:%d:%d", webhook.ID, event.ID)
+		if err := s.enqueuer.Enqueue(ctx, task, asynq.TaskID(taskID)); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Redeliver queues the event of a recorded delivery for sending to the
+// webhook again, e.g. after the receiver fixed a bug. The redelivery is
+// signed anew and keeps the original X-Venio-Delivery ID, so receivers that
+// deduplicate must accept it deliberately.
+func (s *WebhookService) Redeliver(ctx context.Context, webhookID, deliveryID int64) error {
+	webhook, err := s.Get(ctx, webhookID)
+	if err != nil {
+		return err
+	}
+	if !webhook.Enabled {
+		return ErrWebhookDisabled
+	}
+
+	delivery, err := s.repo.GetDelivery(ctx, webhookID, deliveryID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrWebhookDeliveryNotFound
+		}
+		return err
+	}
+	if len(delivery.Payload) == 0 {
+		return ErrWebhookPayloadMissing
+	}
+
+	var event events.Event
+	if err := json.Unmarshal(delivery.Payload, &event); err != nil {
+		return fmt.Errorf("failed to decode delivery payload: %w", err)
+	}
+
+	task, err := tasks.NewWebhookDeliverTask(webhook.ID, &event)
+	if err != nil {
+		return err
+	}
+	return s.enqueuer.Enqueue(ctx, task)
+}
+
+// Deliver sends an event to a webhook and records the attempt. attempt is
+// the 1-based attempt number. A failed attempt counts towards disabling the
+// webhook and is returned so the task is retried with backoff.
+func (s *WebhookService) Deliver(ctx context.Context, payload *tasks.WebhookDeliverPayload, attempt int) error {
+	webhook, err := s.Get(ctx, payload.WebhookID)
+	if err != nil {
+		return err
+	}
+	if !webhook.Enabled {
+		return ErrWebhookDisabled
+	}
+
+	body, err := json.Marshal(payload.Event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	start := time.Now()
+	status, sendErr := s.sender.Send(ctx, webhooks.Request{
+		URL:       webhook.URL,
+		Secret:    webhook.Secret,
+		EventType: payload.Event.Type,
+		EventID:   payload.Event.ID,
+		Body:      body,
+	})
+
+	delivery := &models.WebhookDelivery{
+		WebhookID:  webhook.ID,
+		EventID:    payload.Event.ID,
+		EventType:  payload.Event.Type,
+		Attempt:    attempt,
+		Success:    sendErr == nil,
+		DurationMS: time.Since(start).Milliseconds(),
+		Payload:    body,
+	}
+	if status != 0 {
+		delivery.StatusCode = &status
+	}
+	if sendErr != nil {
+		delivery.Error = sendErr.Error()
+	}
+	// The request already went out, so bookkeeping failures are only logged.
+	// The attempt and the failure count are recorded together, so the
+	// deliveries always explain why a webhook was disabled.
+	reason := fmt.Sprintf("disabled after %d consecutive failed deliveries; last error: %v", s.maxFailures, sendErr)
+	var disabled bool
+	err = database.WithTx(ctx, s.db, func(tx pgx.Tx) error {
+		repo := s.repo.WithTx(tx)
+		if err := repo.AddDelivery(ctx, delivery); err != nil {
+			return err
+		}
+		if sendErr == nil {
+			return repo.RecordSuccess(ctx, webhook.ID)
+		}
+		var err error
+		disabled, err = repo.RecordFailure(ctx, webhook.ID, s.maxFailures, reason)
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed to record delivery to webhook %q: %v", webhook.Name, err)
+		disabled = false
+	}
+
+	if sendErr == nil {
+		return nil
+	}
+	if disabled {
+		log.Printf("Webhook %q %s", webhook.Name, reason)
+		recordAudit(ctx, s.audit, "webhook.disable", "webhook", webhook.ID)
+	}
+
+	return fmt.Errorf("delivery to webhook %q failed: %w", webhook.Name, sendErr)
+}
+
+// PruneDeliveries deletes delivery attempts older than retention and
+// returns how many were removed.
+func (s *WebhookService) PruneDeliveries(ctx context.Context, retention time.Duration) (int64, error) {
+	return s.repo.DeleteDeliveriesBefore(ctx, time.Now().Add(-retention))
+}
+
+// save encrypts the secret and creates or updates the webhook. The
+// decrypted secret is restored on the model afterwards.
+func (s *WebhookService) save(ctx context.Context, webhook *models.Webhook, create bool) error {
+	plainSecret := webhook.Secret
+	encrypted, err := s.encryptor.Encrypt(plainSecret)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+
+	webhook.Secret = encrypted
+	if create {
+		err = s.repo.Create(ctx, webhook)
+	} else {
+		err = s.repo.Update(ctx, webhook)
+	}
+	webhook.Secret = plainSecret
+
+	switch {
+	case errors.Is(err, repositories.ErrDuplicate):
+		return ErrWebhookExists
+	case errors.Is(err, repositories.ErrNotFound):
+		return ErrWebhookNotFound
+	case err != nil:
+		return err
+	}
+
+	action := "webhook.update"
+	if create {
+		action = "webhook.create"
+	}
+	recordAudit(ctx, s.audit, action, "webhook", webhook.ID)
+	return nil
+}
+
+// decrypt replaces the stored ciphertext with the plaintext secret.
+func (s *WebhookService) decrypt(webhook *models.Webhook) error {
+	secret, err := s.encryptor.Decrypt(webhook.Secret)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt secret for webhook %d: %w", webhook.ID, err)
+	}
+	webhook.Secret = secret
+	return nil
+}
+
+// normalizeEvents returns a non-nil event filter; an empty filter
+// subscribes to every event type.
+func normalizeEvents(eventTypes []string) []string {
+	if eventTypes == nil {
+		return []string{}
+	}
+	return eventTypes
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package startup waits for the services Venio depends on, so that the
+// server and worker can start before Postgres and Redis are ready, as
+// happens with docker-compose and Kubernetes.
+package startup
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lusoris/venio/internal/config"
+)
+
+// maxRetryDelay caps the delay between attempts.
+const maxRetryDelay = 30 * time.Second
+
+// Wait calls connect until it succeeds, waiting cfg.RetryDelay after the
+// first failure and twice as long after each further one, for at most
+// cfg.MaxWait in total. name identifies the dependency in the log.
+func Wait(ctx context.Context, name string, cfg config.StartupConfig, connect func(context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, cfg.MaxWait)
+	defer cancel()
+
+	delay := cfg.RetryDelay
+	for attempt := 1; ; attempt++ {
+		err := connect(ctx)
+		if err == nil {
+			if attempt > 1 {
+				log.Printf("Connected to %s after %d attempts", name, attempt)
+			}
+			return nil
+		}
+
+		deadline, _ := ctx.Deadline()
+		if time.Until(deadline) < delay {
+			return fmt.Errorf("%s not ready after %v: %w", name, cfg.MaxWait, err)
+		}
+		log.Printf("Waiting for %s (attempt %d, retrying in %v): %v", name, attempt, delay, err)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return fmt.Errorf("%s not ready after %v: %w", name, cfg.MaxWait, err)
+		}
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package stream broadcasts relayed domain events to realtime clients
+// across API instances through Redis pub/sub.
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/lusoris/venio/internal/events"
+)
+
+// Channel is the Redis pub/sub channel events are published to.
+const Channel = "venio:events"
+
+// maxReplay caps how many missed events a reconnecting client receives.
+const maxReplay = 500
+
+// History returns events that were already published, so reconnecting
+// clients can catch up. *repositories.OutboxRepository implements it.
+type History interface {
+	ListPublishedAfter(ctx context.Context, afterID int64, limit int) ([]*events.Event, error)
+}
+
+// clientBuffer is how many events a slow client may fall behind before
+// events are dropped for it.
+const clientBuffer = 64
+
+// Broker publishes events to Redis and fans the events received from Redis
+// out to the local subscribers. Every API instance runs one broker, so a
+// client receives events no matter which process relayed them.
+type Broker struct {
+	redis   *redis.Client
+	history History
+
+	mu      sync.Mutex
+	clients map[chan *events.Event]struct{}
+}
+
+// NewBroker creates a broker on top of client. history serves replays.
+func NewBroker(client *redis.Client, history History) *Broker {
+	return &Broker{redis: client, history: history, clients: make(map[chan *events.Event]struct{})}
+}
+
+// Replay returns the events published after the event with ID afterID, at
+// most 500 and oldest first. Events are kept for OUTBOX_RETENTION.
+func (b *Broker) Replay(ctx context.Context, afterID int64) ([]*events.Event, error) {
+	return b.history.ListPublishedAfter(ctx, afterID, maxReplay)
+}
+
+// Publish sends event to every broker. It is an events.Handler. Realtime
+// delivery is best effort, so failures are logged instead of making the
+// outbox redeliver the event to every handler.
+func (b *Broker) Publish(ctx context.Context, event *events.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("Failed to encode event %d for streaming: %v", event.ID, err)
+		return nil
+	}
+	if err := b.redis.Publish(ctx, Channel, payload).Err(); err != nil {
+		log.Printf("Failed to publish event %d for streaming: %v", event.ID, err)
+	}
+	return nil
+}
+
+// Run receives events from Redis and broadcasts them until ctx is
+// cancelled. The subscription reconnects on its own after Redis outages;
+// events published in the meantime are lost.
+func (b *Broker) Run(ctx context.Context) {
+	sub := b.redis.Subscribe(ctx, Channel)
+	defer sub.Close()
+
+	messages := sub.Channel(redis.WithChannelHealthCheckInterval(30 * time.Second))
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			var event events.Event
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				log.Printf("Ignored malformed streamed event: %v", err)
+				continue
+			}
+			b.broadcast(&event)
+		}
+	}
+}
+
+// Subscribe registers a local subscriber. The returned function
+// unregisters it and must be called when the client disconnects.
+func (b *Broker) Subscribe() (<-chan *events.Event, func()) {
+	ch := make(chan *events.Event, clientBuffer)
+
+	b.mu.Lock()
+	b.clients[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.clients, ch)
+		b.mu.Unlock()
+	}
+}
+
+// broadcast hands event to every subscriber without blocking on slow ones.
+func (b *Broker) broadcast(event *events.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.clients {
+		select {
+		case ch <- event:
+		default:
+			log.Printf("Dropped event %d for a slow stream client", event.ID)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hibiken/asynq"
+)
+
+// ErrNoHandler is returned by InlineEnqueuer before a handler is attached.
+var ErrNoHandler = errors.New("inline enqueuer has no handler")
+
+// Enqueuer hands tasks off for background processing. Services depend on
+// this interface rather than on Asynq directly so that single-binary
+// deployments can run tasks in-process.
+type Enqueuer interface {
+	Enqueue(ctx context.Context, task *asynq.Task, opts ...asynq.Option) error
+}
+
+// AsynqEnqueuer enqueues tasks into Redis for cmd/worker to process.
+type AsynqEnqueuer struct {
+	client   *asynq.Client
+	policies *RetryPolicies
+}
+
+// NewAsynqEnqueuer creates an enqueuer backed by an Asynq client. Each task
+// goes to its queue (see QueueFor) with the retry limit of its policy unless
+// the caller overrides them.
+func NewAsynqEnqueuer(client *asynq.Client, policies *RetryPolicies) *AsynqEnqueuer {
+	return &AsynqEnqueuer{client: client, policies: policies}
+}
+
+// Enqueue implements Enqueuer.
+func (e *AsynqEnqueuer) Enqueue(ctx context.Context, task *asynq.Task, opts ...asynq.Option) error {
+	// Later options win, so caller-supplied options take precedence.
+	opts = append([]asynq.Option{
+		asynq.Queue(QueueFor(task.Type())),
+		asynq.MaxRetry(e.policies.For(task.Type()).MaxRetry),
+	}, opts...)
+
+	if _, err := e.client.EnqueueContext(ctx, task, opts...); err != nil {
+		return fmt.Errorf("failed to enqueue %s: %w", task.Type(), err)
+	}
+	return nil
+}
+
+// InlineEnqueuer runs tasks synchronously in the calling goroutine. Options
+// such as queues, delays, and retries are ignored. Handler errors are logged
+// and passed to the error handler rather than returned, matching the
+// fire-and-forget contract of Enqueue.
+type InlineEnqueuer struct {
+	mu           sync.RWMutex
+	handler      asynq.Handler
+	errorHandler asynq.ErrorHandler
+}
+
+// NewInlineEnqueuer creates an inline enqueuer. A handler must be attached
+// with SetHandler before tasks are enqueued; this breaks the construction
+// cycle between services and the task handlers that use them.
+func NewInlineEnqueuer() *InlineEnqueuer {
+	return &InlineEnqueuer{}
+}
+
+// SetHandler attaches the handler that processes enqueued tasks.
+func (e *InlineEnqueuer) SetHandler(handler asynq.Handler) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.handler = handler
+}
+
+// SetErrorHandler attaches a handler that is passed the errors of failed
+// tasks, like asynq.Config.ErrorHandler.
+func (e *InlineEnqueuer) SetErrorHandler(handler asynq.ErrorHandler) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.errorHandler = handler
+}
+
+// Enqueue implements Enqueuer.
+func (e *InlineEnqueuer) Enqueue(ctx context.Context, task *asynq.Task, _ ...asynq.Option) error {
+	e.mu.RLock()
+	handler, errorHandler := e.handler, e.errorHandler
+	e.mu.RUnlock()
+
+	if handler == nil {
+		return ErrNoHandler
+	}
+
+	// The task must not be cut short when the originating request ends.
+	ctx = context.WithoutCancel(ctx)
+	if err := handler.ProcessTask(ctx, task); err != nil {
+		log.Printf("Inline task %s failed: %v", task.Type(), err)
+		if errorHandler != nil {
+			errorHandler.HandleError(ctx, task, err)
+		}
+	}
+	return nil
+}
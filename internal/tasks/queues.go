@@ -0,0 +1,44 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package tasks
+
+// Queue names. Each queue is processed by its own pool of workers, so a
+// backlog in one queue never delays tasks in another.
+const (
+	// QueueCritical is for user-facing, latency-sensitive work such as
+	// account emails.
+	QueueCritical = "critical"
+	// QueueDefault is for regular background work such as syncs.
+	QueueDefault = "default"
+	// QueueLow is for bulk and maintenance work such as exports.
+	QueueLow = "low"
+)
+
+// Queues lists all queues in priority order.
+var Queues = []string{QueueCritical, QueueDefault, QueueLow}
+
+// taskQueues maps task types to their queue; unlisted types use QueueDefault.
+var taskQueues = map[string]string{
+	TypeEmailSend:             QueueCritical,
+	TypeArrRefreshProfiles:    QueueDefault,
+	TypeArrRefreshAllProfiles: QueueLow,
+	TypeCacheInvalidate:       QueueLow,
+	TypeWebhookDeliver:        QueueDefault,
+	TypeWebhookPrune:          QueueLow,
+	TypeNotificationSend:      QueueDefault,
+	TypeJobHistoryPrune:       QueueLow,
+	TypeAuditPrune:            QueueLow,
+}
+
+// QueueFor returns the queue a task type is enqueued into.
+func QueueFor(taskType string) string {
+	if queue, ok := taskQueues[taskType]; ok {
+		return queue
+	}
+	return QueueDefault
+}
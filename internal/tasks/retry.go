@@ -0,0 +1,82 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package tasks
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// RetryPolicy controls how often and how quickly a failed task is retried.
+// Tasks that exhaust their retries are archived, which is Asynq's
+// dead-letter queue.
+type RetryPolicy struct {
+	MaxRetry  int
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// Delay returns the backoff before retry number n (starting at 1): the base
+// delay doubled per attempt, capped at MaxDelay, plus up to 10% jitter.
+func (p RetryPolicy) Delay(n int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < n && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if jitter := int64(delay / 10); jitter > 0 {
+		delay += time.Duration(rand.Int64N(jitter))
+	}
+	return delay
+}
+
+// RetryPolicies resolves the retry policy of each task type.
+type RetryPolicies struct {
+	fallback  RetryPolicy
+	overrides map[string]RetryPolicy
+}
+
+// NewRetryPolicies creates the policy table. Task types without a specific
+// policy use fallback.
+func NewRetryPolicies(fallback RetryPolicy) *RetryPolicies {
+	return &RetryPolicies{
+		fallback: fallback,
+		overrides: map[string]RetryPolicy{
+			// Cache maintenance is cheap to redo on the next schedule.
+			TypeCacheInvalidate:       {MaxRetry: 3, BaseDelay: 10 * time.Second, MaxDelay: time.Minute},
+			TypeArrRefreshAllProfiles: {MaxRetry: 3, BaseDelay: 30 * time.Second, MaxDelay: 5 * time.Minute},
+			TypeWebhookPrune:          {MaxRetry: 3, BaseDelay: time.Minute, MaxDelay: 10 * time.Minute},
+			TypeJobHistoryPrune:       {MaxRetry: 3, BaseDelay: time.Minute, MaxDelay: 10 * time.Minute},
+			TypeAuditPrune:            {MaxRetry: 3, BaseDelay: time.Minute, MaxDelay: 10 * time.Minute},
+			TypeEmailSend:             {MaxRetry: 8, BaseDelay: 30 * time.Second, MaxDelay: 30 * time.Minute},
+			// Notifications lose their value quickly, so give up within hours.
+			TypeNotificationSend: {MaxRetry: 6, BaseDelay: 30 * time.Second, MaxDelay: 30 * time.Minute},
+			// Give webhook receivers roughly a day to recover from an outage.
+			TypeWebhookDeliver: {MaxRetry: 12, BaseDelay: 30 * time.Second, MaxDelay: 6 * time.Hour},
+		},
+	}
+}
+
+// For returns the policy for the given task type.
+func (r *RetryPolicies) For(taskType string) RetryPolicy {
+	if policy, ok := r.overrides[taskType]; ok {
+		return policy
+	}
+	return r.fallback
+}
+
+// RetryDelayFunc returns an asynq.RetryDelayFunc applying the policies.
+func (r *RetryPolicies) RetryDelayFunc() asynq.RetryDelayFunc {
+	return func(n int, _ error, t *asynq.Task) time.Duration {
+		return r.For(t.Type()).Delay(n)
+	}
+}
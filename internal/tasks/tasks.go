@@ -0,0 +1,148 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package tasks defines the background task types and payloads shared by
+// the API server (which enqueues them) and the worker (which handles them).
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/cache"
+	"github.com/lusoris/venio/internal/events"
+	"github.com/lusoris/venio/internal/notifications"
+)
+
+// Task type names.
+const (
+	TypeCacheInvalidate       = "cache:invalidate"
+	TypeArrRefreshProfiles    = "arr:refresh_profiles"
+	TypeArrRefreshAllProfiles = "arr:refresh_all_profiles"
+	TypeWebhookDeliver        = "webhook:deliver"
+	TypeWebhookPrune          = "webhook:prune_deliveries"
+	TypeJobHistoryPrune       = "jobs:prune_history"
+	TypeAuditPrune            = "audit:prune"
+	TypeEmailSend             = "email:send"
+	TypeNotificationSend      = "notification:send"
+)
+
+// CacheInvalidatePayload is the payload of TypeCacheInvalidate.
+type CacheInvalidatePayload struct {
+	// Kind limits invalidation to one metadata kind; empty purges all.
+	Kind cache.Kind `json:"kind,omitempty"`
+}
+
+// ArrRefreshProfilesPayload is the payload of TypeArrRefreshProfiles.
+type ArrRefreshProfilesPayload struct {
+	InstanceID int64 `json:"instance_id"`
+}
+
+// NewCacheInvalidateTask creates a task that purges cached metadata.
+func NewCacheInvalidateTask(kind cache.Kind) (*asynq.Task, error) {
+	return newTask(TypeCacheInvalidate, CacheInvalidatePayload{Kind: kind})
+}
+
+// NewArrRefreshProfilesTask creates a task that reloads the quality profiles
+// of an Arr instance into the metadata cache.
+func NewArrRefreshProfilesTask(instanceID int64) (*asynq.Task, error) {
+	return newTask(TypeArrRefreshProfiles, ArrRefreshProfilesPayload{InstanceID: instanceID})
+}
+
+// ArrRefreshAllProfilesPayload is the payload of TypeArrRefreshAllProfiles.
+type ArrRefreshAllProfilesPayload struct {
+	// JobID links the task to a job status entry; empty for scheduled runs.
+	JobID string `json:"job_id,omitempty"`
+}
+
+// NewArrRefreshAllProfilesTask creates a task that refreshes the profiles of
+// every enabled Arr instance, reporting progress under jobID if it is set.
+func NewArrRefreshAllProfilesTask(jobID string) (*asynq.Task, error) {
+	return newTask(TypeArrRefreshAllProfiles, ArrRefreshAllProfilesPayload{JobID: jobID})
+}
+
+// WebhookDeliverPayload is the payload of TypeWebhookDeliver.
+type WebhookDeliverPayload struct {
+	WebhookID int64         `json:"webhook_id"`
+	Event     *events.Event `json:"event"`
+}
+
+// NewWebhookDeliverTask creates a task that sends event to one webhook.
+func NewWebhookDeliverTask(webhookID int64, event *events.Event) (*asynq.Task, error) {
+	return newTask(TypeWebhookDeliver, WebhookDeliverPayload{WebhookID: webhookID, Event: event})
+}
+
+// NewWebhookPruneTask creates a task that deletes webhook delivery history
+// past its retention.
+func NewWebhookPruneTask() (*asynq.Task, error) {
+	return newTask(TypeWebhookPrune, struct{}{})
+}
+
+// NewJobHistoryPruneTask creates a task that deletes job runs past their
+// retention.
+func NewJobHistoryPruneTask() (*asynq.Task, error) {
+	return newTask(TypeJobHistoryPrune, struct{}{})
+}
+
+// NewAuditPruneTask creates a task that deletes audit log entries past
+// their retention.
+func NewAuditPruneTask() (*asynq.Task, error) {
+	return newTask(TypeAuditPrune, struct{}{})
+}
+
+// EmailSendPayload is the payload of TypeEmailSend.
+type EmailSendPayload struct {
+	Template string `json:"template"`
+	// Locale is the recipient's locale; empty uses the default locale.
+	Locale string `json:"locale,omitempty"`
+	To     string `json:"to"`
+	// Data is the template data; templates address its fields by their
+	// JSON names.
+	Data json.RawMessage `json:"data"`
+}
+
+// NewEmailSendTask creates a task that renders the named email template
+// with data in the given locale and sends it to one recipient.
+func NewEmailSendTask(template, locale, to string, data any) (*asynq.Task, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s email data: %w", template, err)
+	}
+	return newTask(TypeEmailSend, EmailSendPayload{Template: template, Locale: locale, To: to, Data: encoded})
+}
+
+// NotificationSendPayload is the payload of TypeNotificationSend.
+type NotificationSendPayload struct {
+	AgentID      int64                       `json:"agent_id"`
+	Notification *notifications.Notification `json:"notification"`
+}
+
+// NewNotificationSendTask creates a task that sends n through one
+// notification agent.
+func NewNotificationSendTask(agentID int64, n *notifications.Notification) (*asynq.Task, error) {
+	return newTask(TypeNotificationSend, NotificationSendPayload{AgentID: agentID, Notification: n})
+}
+
+// Decode unmarshals a task payload into v. Malformed payloads are wrapped
+// with asynq.SkipRetry because retrying them can never succeed.
+func Decode(t *asynq.Task, v any) error {
+	if err := json.Unmarshal(t.Payload(), v); err != nil {
+		return fmt.Errorf("invalid %s payload: %v: %w", t.Type(), err, asynq.SkipRetry)
+	}
+	return nil
+}
+
+// newTask encodes payload as JSON and wraps it in a task of the given type.
+func newTask(taskType string, payload any) (*asynq.Task, error) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s payload: %w", taskType, err)
+	}
+	return asynq.NewTask(taskType, encoded), nil
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package webhooks signs and sends outgoing webhook requests.
+//
+// Every request carries the headers below. Receivers verify a request by
+// computing HMAC-SHA256 over "<timestamp>.<body>" with the shared secret and
+// comparing it to the signature, and should reject stale timestamps to
+// prevent replays.
+//
+//	X-Venio-Event:     event type, e.g. "instance.created"
+//	X-Venio-Delivery:  event ID; stable across retries of the same event
+//	X-Venio-Timestamp: Unix time the request was signed
+//	X-Venio-Signature: "sha256=" followed by the hex-encoded HMAC
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Header names set on every webhook request.
+const (
+	HeaderEvent     = "X-Venio-Event"
+	HeaderDelivery  = "X-Venio-Delivery"
+	HeaderTimestamp = "X-Venio-Timestamp"
+	HeaderSignature = "X-Venio-Signature"
+)
+
+// signaturePrefix identifies the signing algorithm in HeaderSignature.
+const signaturePrefix = "sha256="
+
+// Sign returns the HeaderSignature value for body sent at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature matches body sent at timestamp.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Request is a single webhook delivery.
+type Request struct {
+	URL       string
+	Secret    string
+	EventType string
+	EventID   int64
+	Body      []byte
+}
+
+// Sender posts signed webhook requests.
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender creates a sender that gives up on a request after timeout.
+func NewSender(timeout time.Duration) *Sender {
+	return &Sender{client: &http.Client{Timeout: timeout}}
+}
+
+// Send posts req and returns the response status code. Any status outside
+// 2xx is returned as an error together with the code; the code is zero when
+// no response was received.
+func (s *Sender) Send(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "Venio-Webhook")
+	httpReq.Header.Set(HeaderEvent, req.EventType)
+	httpReq.Header.Set(HeaderDelivery, strconv.FormatInt(req.EventID, 10))
+	httpReq.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, timestamp, req.Body))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reach endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain a little of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("endpoint returned %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
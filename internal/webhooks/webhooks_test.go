@@ -0,0 +1,58 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event":"test"}`)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(`1700000000.{"event":"test"}`))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if got := Sign("secret", 1700000000, body); got != want {
+		t.Errorf("Sign() = %q, want %q", got, want)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"event":"test"}`)
+	signature := Sign("secret", 1700000000, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{"valid", "secret", 1700000000, body, signature, true},
+		{"wrong secret", "other", 1700000000, body, signature, false},
+		{"wrong timestamp", "secret", 1700000001, body, signature, false},
+		{"modified body", "secret", 1700000000, []byte(`{"event":"tset"}`), signature, false},
+		{"missing prefix", "secret", 1700000000, body, signature[len("sha256="):], false},
+		{"empty signature", "secret", 1700000000, body, "", false},
+		{"uppercase hex", "secret", 1700000000, body, "sha256=" + strings.ToUpper(signature[len("sha256="):]), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.timestamp, tt.body, tt.signature); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
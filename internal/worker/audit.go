@@ -0,0 +1,47 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/audit"
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/repositories"
+)
+
+// NewAuditLogger creates the audit logger for the configured storage and
+// a function that writes the buffered entries on shutdown. When auditing
+// is disabled entries are discarded.
+func NewAuditLogger(cfg config.AuditConfig, entries *repositories.AuditRepository) (audit.Logger, func()) {
+	if !cfg.Enabled {
+		return audit.Discard, func() {}
+	}
+
+	var writer audit.Writer = audit.StdLogger{}
+	if cfg.Storage == config.AuditStorageDatabase {
+		writer = audit.WriterFunc(entries.Add)
+	}
+	logger := audit.NewAsyncLogger(writer, cfg.BufferSize)
+	return logger, logger.Close
+}
+
+// handleAuditPrune deletes audit log entries past their retention.
+func (h *handlers) handleAuditPrune(ctx context.Context, _ *asynq.Task) error {
+	deleted, err := h.deps.AuditEntries.DeleteBefore(ctx, time.Now().Add(-h.deps.AuditRetention))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Pruned %d audit log entries", deleted)
+	return nil
+}
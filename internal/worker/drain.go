@@ -0,0 +1,94 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/hibiken/asynq"
+)
+
+// drainTracker counts in-flight tasks so that shutdown can report how many
+// finished during the drain and how many were aborted at the timeout.
+type drainTracker struct {
+	active   atomic.Int64
+	draining atomic.Bool
+	drained  atomic.Int64
+}
+
+// middleware wraps a handler to track its in-flight tasks.
+func (d *drainTracker) middleware(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+		d.active.Add(1)
+		defer func() {
+			d.active.Add(-1)
+			if d.draining.Load() {
+				d.drained.Add(1)
+			}
+		}()
+
+		return next.ProcessTask(ctx, t)
+	})
+}
+
+// Run starts the servers and blocks until SIGINT or SIGTERM. It then stops
+// fetching new tasks, waits for in-flight tasks up to the configured
+// shutdown timeout, and logs how many drained and how many were aborted.
+// Aborted tasks are returned to the queue by Asynq and retried later.
+func Run(servers []*asynq.Server, mux *asynq.ServeMux) error {
+	if len(servers) == 0 {
+		return errors.New("no queues enabled")
+	}
+
+	tracker := &drainTracker{}
+	mux.Use(tracker.middleware)
+
+	for i, srv := range servers {
+		if err := srv.Start(mux); err != nil {
+			for _, started := range servers[:i] {
+				started.Shutdown()
+			}
+			return err
+		}
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-sigs
+	signal.Stop(sigs)
+
+	log.Printf("Received %s, draining worker...", sig)
+
+	// Stop fetching new tasks before counting what is still running.
+	for _, srv := range servers {
+		srv.Stop()
+	}
+	tracker.draining.Store(true)
+	inFlight := tracker.active.Load()
+
+	var wg sync.WaitGroup
+	for _, srv := range servers {
+		wg.Add(1)
+		go func(srv *asynq.Server) {
+			defer wg.Done()
+			srv.Shutdown()
+		}(srv)
+	}
+	wg.Wait()
+
+	log.Printf("Worker drained: %d in flight, %d finished, %d aborted",
+		inFlight, tracker.drained.Load(), tracker.active.Load())
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hibiken/asynq"
+)
+
+func TestDrainTracker(t *testing.T) {
+	tracker := &drainTracker{}
+
+	// Each task runs until its context is cancelled.
+	started := make(chan struct{})
+	handler := tracker.middleware(asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+
+	// run starts a task and returns a function that finishes it and waits
+	// until the tracker has counted it.
+	run := func() func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			if err := handler.ProcessTask(ctx, asynq.NewTask("test", nil)); !errors.Is(err, context.Canceled) {
+				t.Errorf("ProcessTask() error = %v, want the handler's error", err)
+			}
+		}()
+		<-started
+		return func() {
+			cancel()
+			<-done
+		}
+	}
+
+	finishBefore := run()
+	finishDuring := run()
+	abort := run()
+	defer abort()
+
+	finishBefore()
+	if got := tracker.drained.Load(); got != 0 {
+		t.Errorf("drained = %d before the drain, want 0", got)
+	}
+
+	tracker.draining.Store(true)
+	if got := tracker.active.Load(); got != 2 {
+		t.Fatalf("in flight at the drain = %d, want 2", got)
+	}
+
+	finishDuring()
+	if got := tracker.drained.Load(); got != 1 {
+		t.Errorf("drained = %d, want 1", got)
+	}
+	if got := tracker.active.Load(); got != 1 {
+		t.Errorf("aborted = %d, want 1", got)
+	}
+}
+
+func TestRunWithoutQueues(t *testing.T) {
+	if err := Run(nil, asynq.NewServeMux()); err == nil {
+		t.Error("Run() without servers succeeded, want an error")
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/email"
+	"github.com/lusoris/venio/internal/i18n"
+	"github.com/lusoris/venio/internal/tasks"
+)
+
+// NewEmail creates the email renderer, translating with bundle, and the
+// configured sender, which skips the addresses on suppressions.
+func NewEmail(
+	cfg config.EmailConfig,
+	bundle *i18n.Bundle,
+	suppressions email.SuppressionList,
+) (*email.Renderer, *email.InstrumentedSender, error) {
+	renderer, err := email.NewRenderer(email.Branding{
+		AppName:         cfg.BrandName,
+		LogoURL:         cfg.BrandLogoURL,
+		PrimaryColor:    cfg.BrandPrimaryColor,
+		BackgroundColor: cfg.BrandBackgroundColor,
+	}, bundle, cfg.TemplateDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sender, err := email.New(email.Config{
+		Provider: cfg.Provider,
+		From:     cfg.From,
+		FromName: cfg.FromName,
+		SMTP: email.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			TLSMode:  cfg.SMTPTLS,
+		},
+		SendGridAPIKey:     cfg.SendGridAPIKey,
+		MailgunDomain:      cfg.MailgunDomain,
+		MailgunAPIKey:      cfg.MailgunAPIKey,
+		MailgunBaseURL:     cfg.MailgunBaseURL,
+		SESRegion:          cfg.SESRegion,
+		SESAccessKeyID:     cfg.SESAccessKeyID,
+		SESSecretAccessKey: cfg.SESSecretAccessKey,
+		APITimeout:         cfg.APITimeout,
+		Suppressions:       suppressions,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return renderer, sender, nil
+}
+
+// handleEmailSend renders and sends one email. Emails the provider rejects
+// are not retried.
+func (h *handlers) handleEmailSend(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.EmailSendPayload
+	if err := tasks.Decode(t, &payload); err != nil {
+		return err
+	}
+
+	var data map[string]any
+	if len(payload.Data) > 0 {
+		if err := json.Unmarshal(payload.Data, &data); err != nil {
+			return fmt.Errorf("invalid %s email data: %v: %w", payload.Template, err, asynq.SkipRetry)
+		}
+	}
+
+	msg, err := h.deps.EmailRenderer.Render(payload.Template, payload.Locale, payload.To, data)
+	if err != nil {
+		return fmt.Errorf("%v: %w", err, asynq.SkipRetry)
+	}
+
+	if err := h.deps.EmailSender.Send(ctx, msg); err != nil {
+		if email.IsPermanent(err) {
+			return fmt.Errorf("%v: %w", err, asynq.SkipRetry)
+		}
+		return err
+	}
+
+	log.Printf("Sent %s email", payload.Template)
+	return nil
+}
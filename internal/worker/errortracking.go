@@ -0,0 +1,60 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/errortracking"
+)
+
+// NewErrorReporter creates the error reporter for the configured DSN,
+// tagging events with version, and a function that sends the buffered
+// events on shutdown. Without a DSN events are discarded.
+func NewErrorReporter(cfg config.ErrorTrackingConfig, version string) (errortracking.Reporter, func(), error) {
+	if cfg.DSN == "" {
+		return errortracking.Discard, func() {}, nil
+	}
+
+	hostname, _ := os.Hostname()
+	client, err := errortracking.New(cfg.DSN, errortracking.Options{
+		Environment: cfg.Environment,
+		Release:     "venio@" + version,
+		ServerName:  hostname,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Printf("Reporting errors to %s", client.Host())
+	return client, client.Close, nil
+}
+
+// TaskErrorReporter returns an error handler that reports failed tasks to
+// reporter. Inline tasks are not retried, so every failure is reported.
+func TaskErrorReporter(reporter errortracking.Reporter) asynq.ErrorHandler {
+	return asynq.ErrorHandlerFunc(func(ctx context.Context, task *asynq.Task, err error) {
+		reportTaskError(ctx, reporter, task, err)
+	})
+}
+
+// reportTaskError reports a task that failed for good.
+func reportTaskError(ctx context.Context, reporter errortracking.Reporter, task *asynq.Task, err error) {
+	tags := map[string]string{"task_type": task.Type()}
+	if taskID, ok := asynq.GetTaskID(ctx); ok {
+		tags["task_id"] = taskID
+	}
+	if queue, ok := asynq.GetQueueName(ctx); ok {
+		tags["queue"] = queue
+	}
+	reporter.Report(ctx, &errortracking.Event{Err: err, Tags: tags})
+}
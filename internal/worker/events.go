@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"context"
+	"log"
+
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/events"
+	"github.com/lusoris/venio/internal/outbox"
+	"github.com/lusoris/venio/internal/services"
+	"github.com/lusoris/venio/internal/stream"
+)
+
+// NewDispatcher creates the event dispatcher used by the outbox relay with
+// all subscribers registered.
+func NewDispatcher(
+	webhookService *services.WebhookService,
+	notificationService *services.NotificationService,
+	broker *stream.Broker,
+) *events.Dispatcher {
+	dispatcher := events.NewDispatcher()
+	dispatcher.SubscribeAll("log", logEvent)
+	dispatcher.SubscribeAll("webhooks", webhookService.Fanout)
+	dispatcher.SubscribeAll("notifications", notificationService.Fanout)
+	dispatcher.SubscribeAll("stream", broker.Publish)
+	return dispatcher
+}
+
+// OutboxConfig converts the outbox configuration for the relay.
+func OutboxConfig(cfg config.OutboxConfig) outbox.Config {
+	return outbox.Config{
+		PollInterval:   cfg.PollInterval,
+		BatchSize:      cfg.BatchSize,
+		MaxAttempts:    cfg.MaxAttempts,
+		RetryBaseDelay: cfg.RetryBaseDelay,
+		RetryMaxDelay:  cfg.RetryMaxDelay,
+		Retention:      cfg.Retention,
+	}
+}
+
+// logEvent records every relayed event in the log.
+func logEvent(_ context.Context, event *events.Event) error {
+	log.Printf("Event %s: %s %s", event.Type, event.AggregateType, event.AggregateID)
+	return nil
+}
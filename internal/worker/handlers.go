@@ -0,0 +1,144 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/providers/arr"
+	"github.com/lusoris/venio/internal/services"
+	"github.com/lusoris/venio/internal/tasks"
+)
+
+// handlers implements the task handlers registered in NewMux.
+type handlers struct {
+	deps Dependencies
+}
+
+// handleCacheInvalidate purges cached metadata.
+func (h *handlers) handleCacheInvalidate(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.CacheInvalidatePayload
+	if err := tasks.Decode(t, &payload); err != nil {
+		return err
+	}
+
+	deleted, err := h.deps.MetadataCache.Invalidate(ctx, payload.Kind)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Invalidated %d metadata cache entries (kind=%q)", deleted, payload.Kind)
+	return nil
+}
+
+// handleArrRefreshProfiles reloads an Arr instance's quality profiles into
+// the metadata cache.
+func (h *handlers) handleArrRefreshProfiles(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.ArrRefreshProfilesPayload
+	if err := tasks.Decode(t, &payload); err != nil {
+		return err
+	}
+
+	instance, err := h.deps.InstanceService.Get(ctx, payload.InstanceID)
+	if err != nil {
+		if errors.Is(err, services.ErrInstanceNotFound) {
+			return fmt.Errorf("instance %d: %v: %w", payload.InstanceID, err, asynq.SkipRetry)
+		}
+		return err
+	}
+	if !instance.Type.IsArr() {
+		return fmt.Errorf("instance %d is not an Arr instance: %w", instance.ID, asynq.SkipRetry)
+	}
+
+	count, err := h.refreshProfiles(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Refreshed %d quality profiles for instance %q", count, instance.Name)
+	return nil
+}
+
+// profileRefreshResult is the per-instance outcome of a bulk refresh.
+type profileRefreshResult struct {
+	InstanceID int64  `json:"instance_id"`
+	Name       string `json:"name"`
+	Profiles   int    `json:"profiles"`
+	Error      string `json:"error,omitempty"`
+}
+
+// handleArrRefreshAllProfiles refreshes the profiles of every enabled Arr
+// instance. A failing instance is recorded in the result rather than
+// failing the whole run.
+func (h *handlers) handleArrRefreshAllProfiles(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.ArrRefreshAllProfilesPayload
+	if err := tasks.Decode(t, &payload); err != nil {
+		return err
+	}
+	job := h.newJobReporter(payload.JobID)
+	job.start(ctx)
+
+	instances, err := h.deps.InstanceService.List(ctx)
+	if err != nil {
+		job.fail(ctx, err)
+		return err
+	}
+
+	var arrInstances []*models.Instance
+	for _, instance := range instances {
+		if instance.Type.IsArr() && instance.Enabled {
+			arrInstances = append(arrInstances, instance)
+		}
+	}
+
+	results := make([]profileRefreshResult, 0, len(arrInstances))
+	for i, instance := range arrInstances {
+		result := profileRefreshResult{InstanceID: instance.ID, Name: instance.Name}
+
+		count, err := h.refreshProfiles(ctx, instance)
+		if err != nil {
+			result.Error = err.Error()
+			log.Printf("Failed to refresh quality profiles for instance %q: %v", instance.Name, err)
+		}
+		result.Profiles = count
+		results = append(results, result)
+
+		job.progress(ctx, (i+1)*100/len(arrInstances), "Refreshed "+instance.Name)
+	}
+
+	job.complete(ctx, results)
+	log.Printf("Refreshed quality profiles for %d instances", len(arrInstances))
+	return nil
+}
+
+// refreshProfiles replaces the cached quality profiles of an Arr instance and
+// returns how many profiles it has.
+func (h *handlers) refreshProfiles(ctx context.Context, instance *models.Instance) (int, error) {
+	client := arr.NewClient(instance, &http.Client{Timeout: h.deps.ArrRequestTimeout})
+	key := strconv.FormatInt(instance.ID, 10)
+
+	if err := h.deps.MetadataCache.InvalidateArrProfiles(ctx, key); err != nil {
+		return 0, err
+	}
+	profiles, err := h.deps.MetadataCache.ArrProfiles(ctx, key, func(ctx context.Context) ([]models.QualityProfile, error) {
+		return client.QualityProfiles(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(profiles), nil
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/models"
+	"github.com/lusoris/venio/internal/repositories"
+)
+
+// recordHistory returns middleware that stores every task execution in the
+// job history. Failures to record are logged and never fail the task.
+func recordHistory(runs *repositories.JobRunRepository) asynq.MiddlewareFunc {
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+			started := time.Now()
+			err := next.ProcessTask(ctx, t)
+			finished := time.Now()
+
+			taskID, _ := asynq.GetTaskID(ctx)
+			queue, _ := asynq.GetQueueName(ctx)
+			retried, _ := asynq.GetRetryCount(ctx)
+			digest := sha256.Sum256(t.Payload())
+
+			run := &models.JobRun{
+				TaskID:        taskID,
+				Type:          t.Type(),
+				Queue:         queue,
+				Status:        models.JobRunSucceeded,
+				Attempt:       retried + 1,
+				DurationMS:    finished.Sub(started).Milliseconds(),
+				PayloadDigest: hex.EncodeToString(digest[:]),
+				StartedAt:     started,
+				FinishedAt:    finished,
+			}
+			if err != nil {
+				run.Status = models.JobRunFailed
+				run.Error = err.Error()
+			}
+
+			// The run is recorded even after shutdown canceled the task;
+			// DATABASE_STATEMENT_TIMEOUT bounds the write.
+			if recordErr := runs.Add(context.WithoutCancel(ctx), run); recordErr != nil {
+				log.Printf("Failed to record run of task %s: %v", t.Type(), recordErr)
+			}
+
+			return err
+		})
+	}
+}
+
+// handleJobHistoryPrune deletes job runs past their retention.
+func (h *handlers) handleJobHistoryPrune(ctx context.Context, _ *asynq.Task) error {
+	deleted, err := h.deps.JobRuns.DeleteBefore(ctx, time.Now().Add(-h.deps.JobHistoryRetention))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Pruned %d job runs", deleted)
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"context"
+	"log"
+
+	"github.com/lusoris/venio/internal/jobs"
+)
+
+// jobReporter records task progress in the job status store. It is a no-op
+// for tasks that were not started through the API and carry no job ID.
+// Store errors are logged and never fail the task itself.
+type jobReporter struct {
+	store *jobs.Store
+	id    string
+}
+
+// newJobReporter creates a reporter for the given job ID.
+func (h *handlers) newJobReporter(id string) *jobReporter {
+	return &jobReporter{store: h.deps.JobStore, id: id}
+}
+
+func (r *jobReporter) start(ctx context.Context) {
+	if r.id != "" {
+		r.check(r.store.Start(ctx, r.id))
+	}
+}
+
+func (r *jobReporter) progress(ctx context.Context, percent int, message string) {
+	if r.id != "" {
+		r.check(r.store.Progress(ctx, r.id, percent, message))
+	}
+}
+
+func (r *jobReporter) complete(ctx context.Context, result any) {
+	if r.id != "" {
+		r.check(r.store.Complete(ctx, r.id, result))
+	}
+}
+
+func (r *jobReporter) fail(ctx context.Context, err error) {
+	if r.id != "" {
+		r.check(r.store.Fail(ctx, r.id, err))
+	}
+}
+
+// check logs a failed status update.
+func (r *jobReporter) check(err error) {
+	if err != nil {
+		log.Printf("Failed to update status of job %s: %v", r.id, err)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/services"
+	"github.com/lusoris/venio/internal/tasks"
+)
+
+// handleNotificationSend sends one notification through one agent.
+// Notifications for deleted or disabled agents are dropped, and agents
+// whose settings no longer validate are not retried.
+func (h *handlers) handleNotificationSend(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.NotificationSendPayload
+	if err := tasks.Decode(t, &payload); err != nil {
+		return err
+	}
+	if payload.Notification == nil {
+		return fmt.Errorf("notification task without notification: %w", asynq.SkipRetry)
+	}
+
+	err := h.deps.Notifications.Deliver(ctx, payload.AgentID, payload.Notification)
+	switch {
+	case errors.Is(err, services.ErrNotificationAgentNotFound), errors.Is(err, services.ErrNotificationAgentDisabled):
+		log.Printf("Dropped %s notification to agent %d: %v", payload.Notification.Event, payload.AgentID, err)
+		return nil
+	case errors.Is(err, services.ErrInvalidNotificationAgent):
+		return fmt.Errorf("%v: %w", err, asynq.SkipRetry)
+	}
+	return err
+}
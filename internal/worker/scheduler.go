@@ -0,0 +1,73 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/tasks"
+)
+
+// periodicTask pairs a cron spec from the configuration with the task it
+// enqueues.
+type periodicTask struct {
+	name    string
+	spec    string
+	newTask func() (*asynq.Task, error)
+}
+
+// uniqueWindow keeps several worker replicas from enqueuing the same
+// periodic task twice for one tick.
+const uniqueWindow = time.Minute
+
+// NewScheduler creates an Asynq scheduler with every periodic task that has
+// a non-empty schedule in the configuration.
+func NewScheduler(cfg *config.Config) (*asynq.Scheduler, error) {
+	location, err := time.LoadLocation(cfg.Scheduler.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scheduler timezone %q: %w", cfg.Scheduler.Timezone, err)
+	}
+
+	scheduler := asynq.NewScheduler(RedisOpt(cfg.Redis), &asynq.SchedulerOpts{
+		Location: location,
+		LogLevel: LogLevel(cfg.Log),
+	})
+
+	periodic := []periodicTask{
+		{name: "arr profile refresh", spec: cfg.Scheduler.ArrProfilesRefresh, newTask: func() (*asynq.Task, error) {
+			return tasks.NewArrRefreshAllProfilesTask("")
+		}},
+		{name: "webhook delivery prune", spec: cfg.Scheduler.WebhookPrune, newTask: tasks.NewWebhookPruneTask},
+		{name: "job history prune", spec: cfg.Scheduler.JobHistoryPrune, newTask: tasks.NewJobHistoryPruneTask},
+		{name: "audit log prune", spec: cfg.Scheduler.AuditPrune, newTask: tasks.NewAuditPruneTask},
+	}
+
+	for _, p := range periodic {
+		if p.spec == "" {
+			log.Printf("Periodic task %q disabled", p.name)
+			continue
+		}
+
+		task, err := p.newTask()
+		if err != nil {
+			return nil, err
+		}
+		opts := []asynq.Option{asynq.Queue(tasks.QueueFor(task.Type())), asynq.Unique(uniqueWindow)}
+		if _, err := scheduler.Register(p.spec, task, opts...); err != nil {
+			return nil, fmt.Errorf("failed to schedule %s (%q): %w", p.name, p.spec, err)
+		}
+		log.Printf("Scheduled periodic task %q: %s", p.name, p.spec)
+	}
+
+	return scheduler, nil
+}
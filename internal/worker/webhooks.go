@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/services"
+	"github.com/lusoris/venio/internal/tasks"
+)
+
+// handleWebhookDeliver sends one event to one webhook. Deliveries to deleted
+// or disabled webhooks are dropped without retrying.
+func (h *handlers) handleWebhookDeliver(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.WebhookDeliverPayload
+	if err := tasks.Decode(t, &payload); err != nil {
+		return err
+	}
+	if payload.Event == nil {
+		return fmt.Errorf("webhook delivery without event: %w", asynq.SkipRetry)
+	}
+
+	retried, _ := asynq.GetRetryCount(ctx)
+	err := h.deps.WebhookService.Deliver(ctx, &payload, retried+1)
+	if errors.Is(err, services.ErrWebhookNotFound) || errors.Is(err, services.ErrWebhookDisabled) {
+		log.Printf("Dropped %s delivery to webhook %d: %v", payload.Event.Type, payload.WebhookID, err)
+		return nil
+	}
+	return err
+}
+
+// handleWebhookPrune deletes webhook delivery history past its retention.
+func (h *handlers) handleWebhookPrune(ctx context.Context, _ *asynq.Task) error {
+	deleted, err := h.deps.WebhookService.PruneDeliveries(ctx, h.deps.WebhookRetention)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Pruned %d webhook deliveries", deleted)
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package worker runs background tasks from the Asynq queue.
+package worker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/lusoris/venio/internal/cache"
+	"github.com/lusoris/venio/internal/config"
+	"github.com/lusoris/venio/internal/email"
+	"github.com/lusoris/venio/internal/errortracking"
+	"github.com/lusoris/venio/internal/jobs"
+	"github.com/lusoris/venio/internal/repositories"
+	"github.com/lusoris/venio/internal/services"
+	"github.com/lusoris/venio/internal/tasks"
+)
+
+// Dependencies bundles the services task handlers need.
+type Dependencies struct {
+	MetadataCache   *cache.MetadataCache
+	InstanceService *services.InstanceService
+	WebhookService  *services.WebhookService
+	Notifications   *services.NotificationService
+	JobStore        *jobs.Store
+	JobRuns         *repositories.JobRunRepository
+	AuditEntries    *repositories.AuditRepository
+	EmailRenderer   *email.Renderer
+	EmailSender     email.Sender
+
+	// WebhookRetention is how long webhook delivery attempts are kept.
+	WebhookRetention time.Duration
+	// JobHistoryRetention is how long job runs are kept.
+	JobHistoryRetention time.Duration
+	// AuditRetention is how long audit log entries are kept.
+	AuditRetention time.Duration
+	// ArrRequestTimeout bounds a single request to an Arr instance.
+	ArrRequestTimeout time.Duration
+}
+
+// RedisOpt returns the Asynq connection options for the configured Redis.
+func RedisOpt(cfg config.RedisConfig) asynq.RedisClientOpt {
+	return asynq.RedisClientOpt{
+		Addr:     cfg.Addr(),
+		Password: cfg.Password,
+		DB:       cfg.DB,
+	}
+}
+
+// LogLevel returns the Asynq log level for the configured one, which
+// config validation has checked.
+func LogLevel(cfg config.LogConfig) asynq.LogLevel {
+	var level asynq.LogLevel
+	if err := level.Set(cfg.Level); err != nil {
+		return asynq.InfoLevel
+	}
+	return level
+}
+
+// NewRetryPolicies builds the task retry policies from the configuration.
+func NewRetryPolicies(cfg config.WorkerConfig) *tasks.RetryPolicies {
+	return tasks.NewRetryPolicies(tasks.RetryPolicy{
+		MaxRetry:  cfg.RetryMax,
+		BaseDelay: cfg.RetryBaseDelay,
+		MaxDelay:  cfg.RetryMaxDelay,
+	})
+}
+
+// NewServers creates one Asynq server per queue, each with the concurrency
+// configured for that queue. Queues with a concurrency of zero or less are
+// not processed by this worker. Tasks that fail for good are reported to
+// reporter.
+func NewServers(cfg *config.Config, policies *tasks.RetryPolicies, reporter errortracking.Reporter) []*asynq.Server {
+	var servers []*asynq.Server
+	for _, queue := range tasks.Queues {
+		concurrency := cfg.Worker.QueueConcurrency[queue]
+		if concurrency <= 0 {
+			log.Printf("Queue %q disabled on this worker", queue)
+			continue
+		}
+
+		servers = append(servers, asynq.NewServer(RedisOpt(cfg.Redis), asynq.Config{
+			Concurrency:     concurrency,
+			Queues:          map[string]int{queue: 1},
+			RetryDelayFunc:  policies.RetryDelayFunc(),
+			ErrorHandler:    taskErrorHandler(reporter),
+			ShutdownTimeout: cfg.Worker.ShutdownTimeout,
+			LogLevel:        LogLevel(cfg.Log),
+		}))
+		log.Printf("Processing queue %q with %d workers", queue, concurrency)
+	}
+	return servers
+}
+
+// taskErrorHandler logs failed task attempts and flags tasks that will not
+// be retried again and therefore end up in the dead-letter (archived)
+// queue. Those are also reported to reporter; attempts that are retried
+// are not, as they often fail only for a moment.
+func taskErrorHandler(reporter errortracking.Reporter) asynq.ErrorHandlerFunc {
+	return func(ctx context.Context, task *asynq.Task, err error) {
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		taskID, _ := asynq.GetTaskID(ctx)
+
+		if retried >= maxRetry || errors.Is(err, asynq.SkipRetry) {
+			log.Printf("Task %s (%s) moved to dead-letter queue after %d retries: %v", task.Type(), taskID, retried, err)
+			reportTaskError(ctx, reporter, task, err)
+			return
+		}
+		log.Printf("Task %s (%s) failed, attempt %d of %d: %v", task.Type(), taskID, retried+1, maxRetry+1, err)
+	}
+}
+
+// NewMux registers a handler for every task type. Every execution is
+// recorded in the job history.
+func NewMux(deps Dependencies) *asynq.ServeMux {
+	h := &handlers{deps: deps}
+
+	mux := asynq.NewServeMux()
+	mux.Use(recordHistory(deps.JobRuns))
+	mux.HandleFunc(tasks.TypeCacheInvalidate, h.handleCacheInvalidate)
+	mux.HandleFunc(tasks.TypeArrRefreshProfiles, h.handleArrRefreshProfiles)
+	mux.HandleFunc(tasks.TypeArrRefreshAllProfiles, h.handleArrRefreshAllProfiles)
+	mux.HandleFunc(tasks.TypeWebhookDeliver, h.handleWebhookDeliver)
+	mux.HandleFunc(tasks.TypeWebhookPrune, h.handleWebhookPrune)
+	mux.HandleFunc(tasks.TypeJobHistoryPrune, h.handleJobHistoryPrune)
+	mux.HandleFunc(tasks.TypeAuditPrune, h.handleAuditPrune)
+	mux.HandleFunc(tasks.TypeEmailSend, h.handleEmailSend)
+	mux.HandleFunc(tasks.TypeNotificationSend, h.handleNotificationSend)
+
+	return mux
+}
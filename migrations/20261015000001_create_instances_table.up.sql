@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS instances (
+    id                         BIGSERIAL PRIMARY KEY,
+    name                       VARCHAR(100) NOT NULL UNIQUE,
+    type                       VARCHAR(20)  NOT NULL,
+    url                        TEXT         NOT NULL,
+    api_key_encrypted          TEXT         NOT NULL,
+    default_quality_profile_id INTEGER,
+    default_root_folder        TEXT         NOT NULL DEFAULT '',
+    is_4k                      BOOLEAN      NOT NULL DEFAULT FALSE,
+    is_default                 BOOLEAN      NOT NULL DEFAULT FALSE,
+    enabled                    BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at                 TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at                 TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_instances_type ON instances (type);
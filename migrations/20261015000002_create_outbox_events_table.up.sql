@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id             BIGSERIAL PRIMARY KEY,
+    event_type     VARCHAR(100) NOT NULL,
+    aggregate_type VARCHAR(50)  NOT NULL,
+    aggregate_id   VARCHAR(64)  NOT NULL,
+    payload        JSONB        NOT NULL DEFAULT '{}',
+    attempts       INTEGER      NOT NULL DEFAULT 0,
+    last_error     TEXT,
+    created_at     TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    published_at   TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (id) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_published_at ON outbox_events (published_at) WHERE published_at IS NOT NULL;
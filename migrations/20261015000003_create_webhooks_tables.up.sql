@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS webhooks (
+    id                   BIGSERIAL PRIMARY KEY,
+    name                 VARCHAR(100) NOT NULL UNIQUE,
+    url                  TEXT         NOT NULL,
+    secret_encrypted     TEXT         NOT NULL,
+    events               TEXT[]       NOT NULL DEFAULT '{}',
+    enabled              BOOLEAN      NOT NULL DEFAULT TRUE,
+    consecutive_failures INTEGER      NOT NULL DEFAULT 0,
+    disabled_reason      TEXT         NOT NULL DEFAULT '',
+    created_at           TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id          BIGSERIAL PRIMARY KEY,
+    webhook_id  BIGINT       NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_id    BIGINT       NOT NULL,
+    event_type  VARCHAR(100) NOT NULL,
+    attempt     INTEGER      NOT NULL,
+    success     BOOLEAN      NOT NULL,
+    status_code INTEGER,
+    error       TEXT         NOT NULL DEFAULT '',
+    duration_ms BIGINT       NOT NULL,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook ON webhook_deliveries (webhook_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_created_at ON webhook_deliveries (created_at);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS job_runs (
+    id             BIGSERIAL PRIMARY KEY,
+    task_id        VARCHAR(64)  NOT NULL DEFAULT '',
+    task_type      VARCHAR(100) NOT NULL,
+    queue          VARCHAR(50)  NOT NULL DEFAULT '',
+    status         VARCHAR(20)  NOT NULL,
+    attempt        INTEGER      NOT NULL,
+    duration_ms    BIGINT       NOT NULL,
+    error          TEXT         NOT NULL DEFAULT '',
+    payload_digest CHAR(64)     NOT NULL,
+    started_at     TIMESTAMPTZ  NOT NULL,
+    finished_at    TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_runs_type_finished_at ON job_runs (task_type, finished_at DESC);
+CREATE INDEX IF NOT EXISTS idx_job_runs_finished_at ON job_runs (finished_at);
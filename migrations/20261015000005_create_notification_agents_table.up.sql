@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS notification_agents (
+    id                 BIGSERIAL PRIMARY KEY,
+    name               VARCHAR(100) NOT NULL UNIQUE,
+    type               VARCHAR(20)  NOT NULL,
+    settings_encrypted TEXT         NOT NULL,
+    events             TEXT[]       NOT NULL DEFAULT '{}',
+    enabled            BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at         TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
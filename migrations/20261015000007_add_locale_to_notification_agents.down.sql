@@ -0,0 +1 @@
+ALTER TABLE notification_agents DROP COLUMN IF EXISTS locale;
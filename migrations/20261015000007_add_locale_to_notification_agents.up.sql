@@ -0,0 +1 @@
+ALTER TABLE notification_agents ADD COLUMN IF NOT EXISTS locale VARCHAR(20) NOT NULL DEFAULT '';
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS email_suppressions (
+    id         BIGSERIAL PRIMARY KEY,
+    email      VARCHAR(320) NOT NULL UNIQUE,
+    reason     VARCHAR(20)  NOT NULL,
+    provider   VARCHAR(20)  NOT NULL,
+    detail     TEXT         NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id          BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMPTZ  NOT NULL,
+    action      VARCHAR(100) NOT NULL,
+    resource    VARCHAR(50)  NOT NULL DEFAULT '',
+    resource_id VARCHAR(100) NOT NULL DEFAULT '',
+    actor       VARCHAR(100) NOT NULL,
+    client_ip   VARCHAR(45)  NOT NULL DEFAULT '',
+    method      VARCHAR(10)  NOT NULL DEFAULT '',
+    route       TEXT         NOT NULL DEFAULT '',
+    path        TEXT         NOT NULL DEFAULT '',
+    status      INTEGER      NOT NULL DEFAULT 0,
+    duration_ms BIGINT       NOT NULL DEFAULT 0,
+    request_id  VARCHAR(128) NOT NULL DEFAULT '',
+    body        JSONB
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_occurred_at ON audit_log (occurred_at);
+CREATE INDEX IF NOT EXISTS idx_audit_log_resource ON audit_log (resource, resource_id);
@@ -0,0 +1,3 @@
+ALTER TABLE outbox_events
+    DROP COLUMN IF EXISTS delivered_to,
+    DROP COLUMN IF EXISTS next_attempt_at;
@@ -0,0 +1,3 @@
+ALTER TABLE outbox_events
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS delivered_to    TEXT[]      NOT NULL DEFAULT '{}';
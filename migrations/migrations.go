@@ -0,0 +1,17 @@
+// Copyright (C) 2026 Venio Contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License v3.0
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+// Package migrations embeds the SQL migrations of the database schema, so
+// the binaries can apply them without the source tree.
+package migrations
+
+import "embed"
+
+// FS holds the up and down migrations.
+//
+//go:embed *.sql
+var FS embed.FS